@@ -13,15 +13,30 @@ const cfgFilePath = ".env"
 
 type (
 	Config struct {
-		App       app
-		Mongo     mongo
-		Nats      nats
-		JetStream jetstream
+		App        app
+		Mongo      mongo
+		Nats       nats
+		JetStream  jetstream
+		Auth       auth
+		WS         ws
+		SSE        sse
+		GRPC       grpc
+		NGFilter   ngFilter
+		Purge      purge
+		Retention  retention
+		Mention    mention
+		Reaction   reaction
+		Storage    storage
+		Attachment attachment
 	}
 
 	app struct {
-		Port     string `env:"APP_PORT"      env-required:"true"`
-		LogLevel string `env:"APP_LOG_LEVEL" env-default:"DEBUG"`
+		Port              string `env:"APP_PORT"                env-required:"true"`
+		LogLevel          string `env:"APP_LOG_LEVEL"           env-default:"DEBUG"`
+		ReadHeaderTimeout int    `env:"APP_READ_HEADER_TIMEOUT" env-default:"5000"`
+		ReadTimeout       int    `env:"APP_READ_TIMEOUT"        env-default:"15000"`
+		WriteTimeout      int    `env:"APP_WRITE_TIMEOUT"       env-default:"15000"`
+		IdleTimeout       int    `env:"APP_IDLE_TIMEOUT"        env-default:"60000"`
 	}
 
 	mongo struct {
@@ -55,6 +70,65 @@ type (
 		Timeout                int    `env:"JS_API_TIMEOUT" env-default:"5"`
 		PublishAsyncTimeout    int    `env:"JS_PUBLISH_ASYNC_TIMEOUT" env-default:"5"`
 		PublishAsyncMaxPending int    `env:"JS_PUBLISH_ASYNC_MAX_PENDING" env-default:"10"`
+		StreamMaxAge           int    `env:"JS_STREAM_MAX_AGE" env-default:"86400000"`
+	}
+
+	auth struct {
+		Secret string `env:"AUTH_SECRET" env-required:"true"`
+	}
+
+	grpc struct {
+		Port string `env:"GRPC_PORT" env-default:"9090"`
+	}
+
+	ngFilter struct {
+		CacheTTL       int      `env:"NG_FILTER_CACHE_TTL"        env-default:"300000"`
+		MaxProgramSize int      `env:"NG_FILTER_MAX_PROGRAM_SIZE" env-default:"400"`
+		LeetMap        []string `env:"NG_FILTER_LEET_MAP"         env-separator:"," env-default:"0=o,1=i,3=e,4=a,5=s,7=t,@=a,$=s"`
+		HitRetention   int      `env:"NG_FILTER_HIT_RETENTION"    env-default:"7776000000"`
+	}
+
+	purge struct {
+		GracePeriod int `env:"MSG_PURGE_GRACE_PERIOD" env-default:"2592000000"`
+		Interval    int `env:"MSG_PURGE_INTERVAL"     env-default:"3600000"`
+	}
+
+	retention struct {
+		Interval int `env:"MSG_RETENTION_INTERVAL" env-default:"3600000"`
+	}
+
+	mention struct {
+		MaxMentions int `env:"MENTION_MAX" env-default:"50"`
+	}
+
+	reaction struct {
+		AllowedEmojis []string `env:"REACTION_ALLOWED_EMOJIS" env-separator:","`
+	}
+
+	storage struct {
+		Backend    string `env:"STORAGE_BACKEND"     env-default:"local"`
+		LocalPath  string `env:"STORAGE_LOCAL_PATH"  env-default:"data/attachments"`
+		NATSBucket string `env:"STORAGE_NATS_BUCKET" env-default:"FUNKEN_ATTACHMENTS"`
+	}
+
+	attachment struct {
+		MaxSize       int64    `env:"ATTACHMENT_MAX_SIZE"        env-default:"10485760"`
+		AllowedTypes  []string `env:"ATTACHMENT_ALLOWED_TYPES"   env-separator:"," env-default:"image/*,video/*,audio/*,application/pdf,text/plain"`
+		MaxPerMessage int      `env:"ATTACHMENT_MAX_PER_MESSAGE" env-default:"10"`
+		UnclaimedTTL  int      `env:"ATTACHMENT_UNCLAIMED_TTL"   env-default:"86400000"`
+	}
+
+	ws struct {
+		AllowedOrigins []string `env:"WS_ALLOWED_ORIGINS"  env-separator:","`
+		SendBuffer     int      `env:"WS_SEND_BUFFER"      env-default:"64"`
+		MaxMessageSize int64    `env:"WS_MAX_MESSAGE_SIZE" env-default:"16384"`
+		WriteTimeout   int      `env:"WS_WRITE_TIMEOUT"    env-default:"10000"`
+		PongTimeout    int      `env:"WS_PONG_TIMEOUT"     env-default:"60000"`
+	}
+
+	sse struct {
+		SendBuffer        int `env:"SSE_SEND_BUFFER"        env-default:"256"`
+		HeartbeatInterval int `env:"SSE_HEARTBEAT_INTERVAL" env-default:"15000"`
 	}
 )
 
@@ -0,0 +1,57 @@
+package event
+
+import (
+	"time"
+
+	"github.com/noxhalley/funken/internal/model"
+)
+
+type Type string
+
+const (
+	MessageCreated  Type = "message.created"
+	MessageDeleted  Type = "message.deleted"
+	MessageRestored Type = "message.restored"
+	MessageEdited   Type = "message.edited"
+	ThreadUpdated   Type = "thread.updated"
+	ReactionAdded   Type = "reaction.added"
+	ReactionRemoved Type = "reaction.removed"
+	MessageRead     Type = "message.read"
+	MemberMentioned Type = "member.mentioned"
+)
+
+// Event is the envelope published on JetStream subjects and forwarded
+// verbatim to realtime clients.
+type Event struct {
+	Type       Type        `json:"type"`
+	GroupID    string      `json:"group_id"`
+	ThreadID   string      `json:"thread_id,omitempty"`
+	Data       interface{} `json:"data"`
+	OccurredAt time.Time   `json:"occurred_at"`
+}
+
+func New(t Type, groupID string, data interface{}) Event {
+	return Event{
+		Type:       t,
+		GroupID:    groupID,
+		Data:       data,
+		OccurredAt: time.Now(),
+	}
+}
+
+// NewMessage builds an event about msg. Events about replies belong to
+// their thread.
+func NewMessage(t Type, msg *model.Message) Event {
+	evt := New(t, msg.GroupID, msg)
+	evt.ThreadID = msg.ParentID
+	return evt
+}
+
+// Subject is the subject evt is published on: the one of its thread when it
+// has one, the one of its group otherwise.
+func (e Event) Subject() string {
+	if e.ThreadID != "" {
+		return ThreadSubject(e.GroupID, e.ThreadID)
+	}
+	return GroupSubject(e.GroupID)
+}
@@ -0,0 +1,47 @@
+package event
+
+const (
+	GroupStream = "FUNKEN_GROUPS"
+
+	groupSubjectPrefix = "funken.groups."
+
+	// MemberStream carries notifications addressed to single members, from
+	// any of their groups.
+	MemberStream = "FUNKEN_MEMBERS"
+
+	memberSubjectPrefix = "funken.members."
+
+	// NGFilterInvalidationSubject is a core NATS subject, not bound to any
+	// stream, on which replicas announce NG filter changes.
+	NGFilterInvalidationSubject = "funken.internal.ng_filters.invalidate"
+)
+
+// GroupStreamSubjects are the subjects bound to GroupStream.
+func GroupStreamSubjects() []string {
+	return []string{groupSubjectPrefix + ">"}
+}
+
+// MemberStreamSubjects are the subjects bound to MemberStream.
+func MemberStreamSubjects() []string {
+	return []string{memberSubjectPrefix + ">"}
+}
+
+func MemberSubject(memberID string) string {
+	return memberSubjectPrefix + memberID
+}
+
+func GroupSubject(groupID string) string {
+	return groupSubjectPrefix + groupID
+}
+
+// ThreadsSubject matches the ThreadSubject of every thread of a group.
+func ThreadsSubject(groupID string) string {
+	return groupSubjectPrefix + groupID + ".threads.*"
+}
+
+// ThreadSubject carries the replies of a thread, so clients can follow one
+// thread without the rest of the group. The group subject only learns
+// about thread activity through ThreadUpdated.
+func ThreadSubject(groupID, threadID string) string {
+	return groupSubjectPrefix + groupID + ".threads." + threadID
+}
@@ -0,0 +1,119 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"github.com/noxhalley/funken/internal/infrastructure/mongodb"
+	"github.com/noxhalley/funken/internal/infrastructure/pubsub"
+)
+
+const checkTimeout = 2 * time.Second
+
+type Status string
+
+const (
+	StatusUp   Status = "up"
+	StatusDown Status = "down"
+)
+
+type Check struct {
+	Status  Status `json:"status"`
+	Latency string `json:"latency,omitempty"`
+	State   string `json:"state,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status    Status                  `json:"status"`
+	Uptime    string                  `json:"uptime"`
+	Checks    map[string]Check        `json:"checks,omitempty"`
+	Consumers []pubsub.ConsumerHealth `json:"consumers,omitempty"`
+}
+
+type Checker struct {
+	mdb     *mongodb.MongoDB
+	broker  pubsub.HealthChecker
+	started time.Time
+}
+
+func NewChecker(mdb *mongodb.MongoDB, broker pubsub.HealthChecker) *Checker {
+	return &Checker{
+		mdb:     mdb,
+		broker:  broker,
+		started: time.Now(),
+	}
+}
+
+// Liveness only tells that the process is serving. Dependencies are left
+// out on purpose so that a broker outage does not get the pod restarted.
+func (c *Checker) Liveness() Report {
+	return Report{
+		Status: StatusUp,
+		Uptime: time.Since(c.started).Round(time.Second).String(),
+	}
+}
+
+// Readiness reports down as soon as Mongo, NATS, JetStream or one of the
+// consumers is unhealthy. A NATS connection which is reconnecting counts
+// as down.
+func (c *Checker) Readiness(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	report := c.Liveness()
+	report.Checks = map[string]Check{
+		"mongodb":   c.checkMongo(ctx),
+		"nats":      c.checkNATS(),
+		"jetstream": c.checkJetStream(ctx),
+	}
+	report.Consumers = c.broker.ConsumersHealth(ctx)
+
+	for _, check := range report.Checks {
+		if check.Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+	for _, cons := range report.Consumers {
+		if !cons.Healthy {
+			report.Status = StatusDown
+		}
+	}
+	return report
+}
+
+func (c *Checker) checkMongo(ctx context.Context) Check {
+	start := time.Now()
+	err := c.mdb.Ping(ctx)
+	return newCheck(err, time.Since(start))
+}
+
+func (c *Checker) checkNATS() Check {
+	state := c.broker.ConnStatus()
+	check := Check{
+		Status: StatusUp,
+		State:  state,
+	}
+	if state != "connected" {
+		check.Status = StatusDown
+	}
+	return check
+}
+
+func (c *Checker) checkJetStream(ctx context.Context) Check {
+	start := time.Now()
+	err := c.broker.PingJetStream(ctx)
+	return newCheck(err, time.Since(start))
+}
+
+func newCheck(err error, latency time.Duration) Check {
+	check := Check{
+		Status:  StatusUp,
+		Latency: latency.String(),
+	}
+	if err != nil {
+		check.Status = StatusDown
+		check.Error = err.Error()
+	}
+	return check
+}
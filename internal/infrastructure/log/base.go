@@ -58,6 +58,20 @@ func AddLogValToCtx(ctx context.Context, key string, val interface{}) context.Co
 	return context.WithValue(ctx, logMapCtxKey, m)
 }
 
+// LogValsFromCtx returns a snapshot of the key-val pairs added with AddLogValToCtx
+func LogValsFromCtx(ctx context.Context) map[string]interface{} {
+	vals := map[string]interface{}{}
+	if m, ok := ctx.Value(logMapCtxKey).(*sync.Map); ok {
+		m.Range(func(key, value any) bool {
+			if key, ok := key.(string); ok {
+				vals[key] = value
+			}
+			return true
+		})
+	}
+	return vals
+}
+
 func Group(key string, args ...any) slog.Attr {
 	return slog.Group(key, args...)
 }
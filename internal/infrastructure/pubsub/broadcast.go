@@ -0,0 +1,65 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Broadcaster sends fire-and-forget messages over core NATS. Every
+// listening process receives every message, which JetStream durable
+// consumers would instead spread across replicas.
+type Broadcaster interface {
+	Broadcast(
+		ctx context.Context,
+		subject string,
+		payload interface{},
+	) error
+
+	Listen(
+		ctx context.Context,
+		subject string,
+		handler func(data []byte),
+	) error
+}
+
+func (jsm *JetStreamManager) Broadcast(
+	ctx context.Context,
+	subject string,
+	payload interface{},
+) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		jsm.logger.Error(ctx, err.Error())
+		return err
+	}
+
+	if err := jsm.conn.Publish(subject, data); err != nil {
+		jsm.logger.Error(ctx, "failed to broadcast message", "subject", subject, "error", err)
+		return err
+	}
+	return nil
+}
+
+// Listen blocks until ctx is done. The subscription is restored by the
+// client after a reconnect, but anything sent while disconnected is lost.
+func (jsm *JetStreamManager) Listen(
+	ctx context.Context,
+	subject string,
+	handler func(data []byte),
+) error {
+	sub, err := jsm.conn.Subscribe(subject, func(msg *nats.Msg) {
+		handler(msg.Data)
+	})
+	if err != nil {
+		jsm.logger.Error(ctx, "failed to listen on subject", "subject", subject, "error", err)
+		return err
+	}
+
+	<-ctx.Done()
+	if err := sub.Unsubscribe(); err != nil {
+		jsm.logger.Warn(ctx, "failed to unsubscribe", "subject", subject, "error", err)
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,113 @@
+package pubsub
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// HealthChecker exposes broker state for liveness and readiness probes.
+type HealthChecker interface {
+	ConnStatus() string
+
+	PingJetStream(ctx context.Context) error
+
+	ConsumersHealth(ctx context.Context) []ConsumerHealth
+}
+
+type ConsumerHealth struct {
+	Stream        string `json:"stream"`
+	Consumer      string `json:"consumer"`
+	Healthy       bool   `json:"healthy"`
+	Paused        bool   `json:"paused"`
+	NumPending    uint64 `json:"num_pending"`
+	NumAckPending int    `json:"num_ack_pending"`
+	Error         string `json:"error,omitempty"`
+}
+
+// consumerRegistry tracks the consumers this process is currently
+// consuming from, the durable ones of Subscribe as well as the ordered
+// ones of Watch. Ordered consumers are recreated under a new name when
+// they fall behind, so entries are keyed by registration rather than by
+// consumer name.
+type consumerRegistry struct {
+	mu        sync.Mutex
+	nextKey   uint64
+	consumers map[uint64]registeredConsumer
+}
+
+type registeredConsumer struct {
+	stream string
+	cons   jetstream.Consumer
+}
+
+func (r *consumerRegistry) add(stream string, cons jetstream.Consumer) uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.consumers == nil {
+		r.consumers = make(map[uint64]registeredConsumer)
+	}
+	r.nextKey++
+	r.consumers[r.nextKey] = registeredConsumer{stream: stream, cons: cons}
+	return r.nextKey
+}
+
+func (r *consumerRegistry) remove(key uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.consumers, key)
+}
+
+func (r *consumerRegistry) snapshot() []registeredConsumer {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]registeredConsumer, 0, len(r.consumers))
+	for _, rc := range r.consumers {
+		res = append(res, rc)
+	}
+	return res
+}
+
+// ConnStatus returns the NATS connection state in lower case, e.g.
+// "connected", "reconnecting" or "closed".
+func (jsm *JetStreamManager) ConnStatus() string {
+	return strings.ToLower(jsm.conn.Status().String())
+}
+
+// PingJetStream checks that the JetStream API of the account answers.
+func (jsm *JetStreamManager) PingJetStream(ctx context.Context) error {
+	_, err := jsm.js.AccountInfo(ctx)
+	return err
+}
+
+func (jsm *JetStreamManager) ConsumersHealth(ctx context.Context) []ConsumerHealth {
+	consumers := jsm.consumers.snapshot()
+
+	res := make([]ConsumerHealth, 0, len(consumers))
+	for _, rc := range consumers {
+		health := ConsumerHealth{
+			Stream: rc.stream,
+		}
+		if cached := rc.cons.CachedInfo(); cached != nil {
+			health.Consumer = cached.Name
+		}
+
+		info, err := rc.cons.Info(ctx)
+		if err != nil {
+			health.Error = err.Error()
+			res = append(res, health)
+			continue
+		}
+
+		health.Consumer = info.Name
+		health.Healthy = true
+		health.Paused = info.Paused
+		health.NumPending = info.NumPending
+		health.NumAckPending = info.NumAckPending
+		res = append(res, health)
+	}
+	return res
+}
@@ -23,9 +23,13 @@ type PubSub interface {
 }
 
 type JetStreamManager struct {
-	logger *log.Logger
-	js     jetstream.JetStream
-	conn   *nats.Conn
+	logger    *log.Logger
+	js        jetstream.JetStream
+	conn      *nats.Conn
+	consumers consumerRegistry
+	// streamMaxAge bounds how long streams keep events, which should not
+	// exceed the shortest message retention of the groups.
+	streamMaxAge time.Duration
 }
 
 var (
@@ -75,9 +79,10 @@ func initJetStream(cfg *config.Config) (*JetStreamManager, error) {
 	}
 
 	return &JetStreamManager{
-		logger: logger,
-		conn:   conn,
-		js:     js,
+		logger:       logger,
+		conn:         conn,
+		js:           js,
+		streamMaxAge: time.Duration(cfg.JetStream.StreamMaxAge) * time.Millisecond,
 	}, nil
 }
 
@@ -99,6 +104,9 @@ func initNATSConn(logger *log.Logger, cfg *config.Config) (*nats.Conn, error) {
 		nats.DisconnectErrHandler(func(c *nats.Conn, err error) {
 			logger.Warn(context.Background(), "disconnected from NATS", "error", err)
 		}),
+		nats.ReconnectHandler(func(c *nats.Conn) {
+			logger.Info(context.Background(), "reconnected to NATS", "url", c.ConnectedUrl())
+		}),
 	}
 
 	return nats.Connect(cfg.Nats.Url, opts...)
@@ -0,0 +1,33 @@
+package pubsub
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// ObjectStorer opens JetStream object stores.
+type ObjectStorer interface {
+	EnsureObjectStore(
+		ctx context.Context,
+		bucket string,
+	) (jetstream.ObjectStore, error)
+}
+
+// EnsureObjectStore implements ObjectStorer. The bucket is created when
+// missing.
+func (jsm *JetStreamManager) EnsureObjectStore(
+	ctx context.Context,
+	bucket string,
+) (jetstream.ObjectStore, error) {
+	store, err := jsm.js.CreateOrUpdateObjectStore(ctx, jetstream.ObjectStoreConfig{
+		Bucket:   bucket,
+		Storage:  jetstream.FileStorage,
+		Replicas: 3,
+	})
+	if err != nil {
+		jsm.logger.Error(ctx, "failed to ensure object store", "bucket", bucket, "error", err)
+		return nil, err
+	}
+	return store, nil
+}
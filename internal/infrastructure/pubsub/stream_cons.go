@@ -3,6 +3,8 @@ package pubsub
 import (
 	"context"
 	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
 )
 
 type StreamConsumerManager interface {
@@ -29,6 +31,27 @@ type StreamConsumerManager interface {
 		ctx context.Context,
 		stream string,
 	) error
+
+	EnsureStream(
+		ctx context.Context,
+		stream string,
+		subjects []string,
+	) error
+}
+
+func (jsm *JetStreamManager) defaultStreamConfig(name string, subjects []string) jetstream.StreamConfig {
+	return jetstream.StreamConfig{
+		Name:        name,
+		Subjects:    subjects,
+		Storage:     jetstream.FileStorage,
+		Replicas:    3,
+		Retention:   jetstream.LimitsPolicy,
+		MaxAge:      jsm.streamMaxAge,
+		MaxBytes:    500 * 1024 * 1024, // 500 MB
+		Discard:     jetstream.DiscardOld,
+		AllowDirect: true,
+		Duplicates:  time.Duration(90) * time.Second, // 90s
+	}
 }
 
 func (jsm *JetStreamManager) PauseConsumer(
@@ -78,3 +101,60 @@ func (jsm *JetStreamManager) DeleteStream(ctx context.Context, name string) erro
 	}
 	return nil
 }
+
+// EnsureStream creates the stream with the default config, or makes sure an
+// existing one also listens on the given subjects and keeps events no longer
+// than configured.
+func (jsm *JetStreamManager) EnsureStream(
+	ctx context.Context,
+	name string,
+	subjects []string,
+) error {
+	if err := jsm.checkStreamIsEmpty(ctx, name); err != nil {
+		return err
+	}
+
+	s, err := jsm.js.Stream(ctx, name)
+	if err == jetstream.ErrStreamNotFound {
+		if _, err := jsm.js.CreateStream(ctx, jsm.defaultStreamConfig(name, subjects)); err != nil {
+			jsm.logger.Error(ctx, "failed to create stream", "stream", name, "error", err)
+			return err
+		}
+		return nil
+	}
+
+	if err != nil {
+		jsm.logger.Error(ctx, "failed to get stream", "stream", name, "error", err)
+		return err
+	}
+
+	info, err := s.Info(ctx)
+	if err != nil {
+		jsm.logger.Error(ctx, "failed to get stream info", "stream", name, "error", err)
+		return err
+	}
+
+	cfg := info.Config
+	existing := make(map[string]struct{}, len(cfg.Subjects))
+	for _, subj := range cfg.Subjects {
+		existing[subj] = struct{}{}
+	}
+
+	changed := cfg.MaxAge != jsm.streamMaxAge
+	cfg.MaxAge = jsm.streamMaxAge
+	for _, subj := range subjects {
+		if _, ok := existing[subj]; !ok {
+			cfg.Subjects = append(cfg.Subjects, subj)
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	if _, err := jsm.js.UpdateStream(ctx, cfg); err != nil {
+		jsm.logger.Error(ctx, "failed to update stream", "stream", name, "error", err)
+		return err
+	}
+	return nil
+}
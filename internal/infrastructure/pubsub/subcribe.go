@@ -5,14 +5,14 @@ import (
 	"time"
 
 	"github.com/nats-io/nats.go/jetstream"
-	"github.com/noxhalley/funken/internal/pkg/utils"
+	"github.com/noxhalley/funken/pkg/utils"
 )
 
 type Subcriber interface {
 	Subscribe(
 		ctx context.Context,
 		subject string,
-		callback func(data interface{}),
+		msgHandler func(data interface{}) error,
 		params SubcribeParams,
 	) error
 }
@@ -57,6 +57,9 @@ func (jsm *JetStreamManager) Subscribe(
 		return err
 	}
 
+	key := jsm.consumers.add(s.CachedInfo().Config.Name, cons)
+	defer jsm.consumers.remove(key)
+
 	<-ctx.Done()
 	cc.Stop()
 	return ctx.Err()
@@ -83,18 +86,7 @@ func (jsm *JetStreamManager) getStream(
 		}
 
 		if err == jetstream.ErrStreamNotFound {
-			s, err = jsm.js.CreateStream(ctx, jetstream.StreamConfig{
-				Name:        streamInput,
-				Subjects:    []string{subject},
-				Storage:     jetstream.FileStorage,
-				Replicas:    3,
-				Retention:   jetstream.LimitsPolicy,
-				MaxAge:      time.Duration(24) * time.Hour, // 1 day
-				MaxBytes:    500 * 1024 * 1024,             // 500 MB
-				Discard:     jetstream.DiscardOld,
-				AllowDirect: true,
-				Duplicates:  time.Duration(90) * time.Second, // 90s
-			})
+			s, err = jsm.js.CreateStream(ctx, jsm.defaultStreamConfig(streamInput, []string{subject}))
 
 			if err != nil {
 				jsm.logger.Error(ctx, "failed to create stream", "error", err)
@@ -0,0 +1,79 @@
+package pubsub
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Watcher follows a stream with an ephemeral ordered consumer. Unlike
+// Subscribe, nothing is acked and every watcher sees every message, which
+// suits fan-out to connected clients.
+type Watcher interface {
+	Watch(
+		ctx context.Context,
+		params WatchParams,
+		handler func(msg WatchedMsg),
+	) error
+}
+
+type WatchParams struct {
+	Stream         string
+	FilterSubjects []string
+	// StartSeq replays the stream from the given sequence, inclusive.
+	// Zero delivers only messages published after the watch starts.
+	StartSeq uint64
+}
+
+type WatchedMsg struct {
+	Subject  string
+	Sequence uint64
+	Data     []byte
+}
+
+func (jsm *JetStreamManager) Watch(
+	ctx context.Context,
+	params WatchParams,
+	handler func(msg WatchedMsg),
+) error {
+	if err := jsm.checkStreamIsEmpty(ctx, params.Stream); err != nil {
+		return err
+	}
+
+	cfg := jetstream.OrderedConsumerConfig{
+		FilterSubjects: params.FilterSubjects,
+		DeliverPolicy:  jetstream.DeliverNewPolicy,
+	}
+	if params.StartSeq > 0 {
+		cfg.DeliverPolicy = jetstream.DeliverByStartSequencePolicy
+		cfg.OptStartSeq = params.StartSeq
+	}
+
+	cons, err := jsm.js.OrderedConsumer(ctx, params.Stream, cfg)
+	if err != nil {
+		jsm.logger.Error(ctx, "failed to create ordered consumer", "stream", params.Stream, "error", err)
+		return err
+	}
+
+	cc, err := cons.Consume(func(msg jetstream.Msg) {
+		watched := WatchedMsg{
+			Subject: msg.Subject(),
+			Data:    msg.Data(),
+		}
+		if meta, metaErr := msg.Metadata(); metaErr == nil {
+			watched.Sequence = meta.Sequence.Stream
+		}
+		handler(watched)
+	})
+	if err != nil {
+		jsm.logger.Error(ctx, "failed to watch stream", "stream", params.Stream, "error", err)
+		return err
+	}
+
+	key := jsm.consumers.add(params.Stream, cons)
+	defer jsm.consumers.remove(key)
+
+	<-ctx.Done()
+	cc.Stop()
+	return ctx.Err()
+}
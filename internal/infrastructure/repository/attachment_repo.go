@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/infrastructure/mongodb"
+	"github.com/noxhalley/funken/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type AttachmentRepository interface {
+	FindOneByConditions(
+		ctx context.Context,
+		filter interface{},
+		opts *options.FindOneOptionsBuilder,
+	) (*model.Attachment, error)
+
+	Create(
+		ctx context.Context,
+		attachment model.Attachment,
+	) error
+
+	Claim(
+		ctx context.Context,
+		IDs []string,
+		groupID string,
+		uploaderID string,
+		messageID string,
+	) ([]model.Attachment, error)
+
+	Release(
+		ctx context.Context,
+		messageID string,
+	) error
+
+	FindByMessageIDs(
+		ctx context.Context,
+		messageIDs []string,
+	) ([]model.Attachment, error)
+
+	FindUnclaimed(
+		ctx context.Context,
+		before time.Time,
+		limit int64,
+	) ([]model.Attachment, error)
+
+	DeleteByIDs(
+		ctx context.Context,
+		IDs []string,
+	) error
+
+	DeleteUnclaimed(
+		ctx context.Context,
+		IDs []string,
+	) ([]string, error)
+
+	EnsureIndexes(ctx context.Context) error
+}
+
+type attachmentRepo struct {
+	logger *log.Logger
+	coll   *mongo.Collection
+}
+
+func NewAttachmentRepository(db *mongodb.MongoDB) AttachmentRepository {
+	coll := db.Client.
+		Database(db.DBName).
+		Collection(model.AttachmentCollectionName)
+
+	return &attachmentRepo{
+		logger: log.With("repository", "attachment_repository"),
+		coll:   coll,
+	}
+}
+
+// FindOneByConditions implements AttachmentRepository.
+func (a *attachmentRepo) FindOneByConditions(
+	ctx context.Context,
+	filter interface{},
+	opts *options.FindOneOptionsBuilder,
+) (*model.Attachment, error) {
+	attachment := model.Attachment{}
+	if err := a.coll.FindOne(ctx, filter, opts).Decode(&attachment); err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+// Create implements AttachmentRepository.
+func (a *attachmentRepo) Create(
+	ctx context.Context,
+	attachment model.Attachment,
+) error {
+	_, err := a.coll.InsertOne(ctx, attachment)
+	return err
+}
+
+// Claim implements AttachmentRepository. It attaches to messageID the
+// attachments among IDs which uploaderID uploaded to the group and which
+// no message claimed yet, and returns them in the order of IDs.
+func (a *attachmentRepo) Claim(
+	ctx context.Context,
+	IDs []string,
+	groupID string,
+	uploaderID string,
+	messageID string,
+) ([]model.Attachment, error) {
+	filter := bson.M{
+		"id":          bson.M{"$in": IDs},
+		"group_id":    groupID,
+		"uploader_id": uploaderID,
+		"message_id":  nil,
+	}
+	update := bson.M{"$set": bson.M{"message_id": messageID, "updated_at": time.Now()}}
+
+	if _, err := a.coll.UpdateMany(ctx, filter, update); err != nil {
+		return nil, err
+	}
+
+	claimed, err := a.FindByMessageIDs(ctx, []string{messageID})
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]model.Attachment, len(claimed))
+	for _, attachment := range claimed {
+		byID[attachment.ID] = attachment
+	}
+	attachments := make([]model.Attachment, 0, len(claimed))
+	for _, ID := range IDs {
+		if attachment, ok := byID[ID]; ok {
+			attachments = append(attachments, attachment)
+		}
+	}
+	return attachments, nil
+}
+
+// Release implements AttachmentRepository. It undoes Claim.
+func (a *attachmentRepo) Release(ctx context.Context, messageID string) error {
+	filter := bson.M{"message_id": messageID}
+	update := bson.M{
+		"$unset": bson.M{"message_id": ""},
+		"$set":   bson.M{"updated_at": time.Now()},
+	}
+
+	_, err := a.coll.UpdateMany(ctx, filter, update)
+	return err
+}
+
+// FindByMessageIDs implements AttachmentRepository.
+func (a *attachmentRepo) FindByMessageIDs(
+	ctx context.Context,
+	messageIDs []string,
+) ([]model.Attachment, error) {
+	filter := bson.M{
+		"message_id": bson.M{
+			"$in": messageIDs,
+		},
+	}
+
+	cursor, err := a.coll.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var attachments []model.Attachment
+	err = cursor.All(ctx, &attachments)
+	return attachments, err
+}
+
+// FindUnclaimed implements AttachmentRepository. It returns attachments
+// uploaded before the given time which no message claimed, oldest first.
+func (a *attachmentRepo) FindUnclaimed(
+	ctx context.Context,
+	before time.Time,
+	limit int64,
+) ([]model.Attachment, error) {
+	filter := bson.M{
+		"message_id": nil,
+		"created_at": bson.M{"$lt": before},
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := a.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var attachments []model.Attachment
+	err = cursor.All(ctx, &attachments)
+	return attachments, err
+}
+
+// DeleteByIDs implements AttachmentRepository. Only the metadata goes, the
+// caller deletes the content from the storage.
+func (a *attachmentRepo) DeleteByIDs(ctx context.Context, IDs []string) error {
+	filter := bson.M{
+		"id": bson.M{
+			"$in": IDs,
+		},
+	}
+
+	_, err := a.coll.DeleteMany(ctx, filter)
+	return err
+}
+
+// DeleteUnclaimed implements AttachmentRepository. It deletes the metadata
+// of the attachments among IDs which no message claimed, and returns the IDs
+// of those it deleted. An attachment claimed in the meantime is left alone,
+// so the caller must only delete the content of the returned ones.
+func (a *attachmentRepo) DeleteUnclaimed(ctx context.Context, IDs []string) ([]string, error) {
+	deleted := make([]string, 0, len(IDs))
+	for _, ID := range IDs {
+		res, err := a.coll.DeleteOne(ctx, bson.M{"id": ID, "message_id": nil})
+		if err != nil {
+			return deleted, err
+		}
+		if res.DeletedCount > 0 {
+			deleted = append(deleted, ID)
+		}
+	}
+	return deleted, nil
+}
+
+// EnsureIndexes implements AttachmentRepository.
+func (a *attachmentRepo) EnsureIndexes(ctx context.Context) error {
+	_, err := a.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "message_id", Value: 1},
+				{Key: "created_at", Value: 1},
+			},
+		},
+	})
+	return err
+}
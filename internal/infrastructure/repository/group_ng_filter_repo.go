@@ -11,6 +11,14 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
+// NGFilterBatch is a set of filter writes applied together. Updates only
+// carry the title, flags and action of a filter over.
+type NGFilterBatch struct {
+	Create []model.GroupNGFilter
+	Update []model.GroupNGFilter
+	Delete []model.GroupNGFilter
+}
+
 type GroupNGFilterRepository interface {
 	FindOneByConditions(
 		ctx context.Context,
@@ -49,6 +57,15 @@ type GroupNGFilterRepository interface {
 		ctx context.Context,
 		groupIDs []string,
 	) error
+
+	WriteBatch(
+		ctx context.Context,
+		batch NGFilterBatch,
+	) error
+
+	FindGlobal(ctx context.Context) ([]model.GroupNGFilter, error)
+
+	EnsureIndexes(ctx context.Context) error
 }
 
 type groupNGFilterRepo struct {
@@ -155,3 +172,63 @@ func (g *groupNGFilterRepo) DeleteByGroupIDs(
 	_, err := g.coll.DeleteMany(ctx, filter)
 	return err
 }
+
+// WriteBatch implements GroupNGFilterRepository. The writes go in a single
+// ordered bulk write, creations first, so a failure halfway leaves too many
+// filters rather than too few.
+func (g *groupNGFilterRepo) WriteBatch(
+	ctx context.Context,
+	batch NGFilterBatch,
+) error {
+	models := make([]mongo.WriteModel, 0, len(batch.Create)+len(batch.Update)+1)
+	for _, f := range batch.Create {
+		models = append(models, mongo.NewInsertOneModel().SetDocument(f))
+	}
+	for _, f := range batch.Update {
+		update := bson.M{"$set": bson.M{
+			"title":      f.Title,
+			"flags":      f.Flags,
+			"action":     f.Action,
+			"updated_at": f.UpdatedAt,
+		}}
+		models = append(models, mongo.NewUpdateOneModel().SetFilter(bson.M{"id": f.ID}).SetUpdate(update))
+	}
+	if len(batch.Delete) > 0 {
+		IDs := make([]string, len(batch.Delete))
+		for i, f := range batch.Delete {
+			IDs[i] = f.ID
+		}
+		models = append(models, mongo.NewDeleteManyModel().SetFilter(bson.M{"id": bson.M{"$in": IDs}}))
+	}
+
+	if len(models) == 0 {
+		return nil
+	}
+	_, err := g.coll.BulkWrite(ctx, models)
+	return err
+}
+
+// FindGlobal implements GroupNGFilterRepository. Global filters are the
+// ones without a group.
+func (g *groupNGFilterRepo) FindGlobal(ctx context.Context) ([]model.GroupNGFilter, error) {
+	filter := bson.M{
+		"group_id": bson.M{
+			"$in": bson.A{nil, ""},
+		},
+	}
+	return g.FindByConditions(ctx, filter, nil)
+}
+
+// EnsureIndexes implements GroupNGFilterRepository.
+func (g *groupNGFilterRepo) EnsureIndexes(ctx context.Context) error {
+	_, err := g.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "group_id", Value: 1}},
+		},
+	})
+	return err
+}
@@ -46,6 +46,8 @@ type GroupRepository interface {
 	) error
 
 	CheckExist(ctx context.Context, ID string) (bool, error)
+
+	EnsureIndexes(ctx context.Context) error
 }
 
 type groupRepo struct {
@@ -122,8 +124,15 @@ func (g *groupRepo) UpdateByID(
 // DeleteByID implements GroupRepository.
 func (g *groupRepo) DeleteByID(ctx context.Context, ID string) error {
 	filter := bson.M{"id": ID}
-	_, err := g.coll.DeleteOne(ctx, filter)
-	return err
+	res, err := g.coll.DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
 }
 
 // CheckExist implements GroupRepository.
@@ -143,3 +152,17 @@ func (g *groupRepo) CheckExist(ctx context.Context, ID string) (bool, error) {
 	}
 	return true, nil
 }
+
+// EnsureIndexes implements GroupRepository.
+func (g *groupRepo) EnsureIndexes(ctx context.Context) error {
+	_, err := g.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "created_at", Value: -1}},
+		},
+	})
+	return err
+}
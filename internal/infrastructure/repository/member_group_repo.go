@@ -10,16 +10,57 @@ import (
 	"github.com/noxhalley/funken/internal/model"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
+type UnreadCount struct {
+	GroupID string `bson:"group_id" json:"group_id"`
+	Count   int64  `bson:"count"    json:"count"`
+}
+
 type MemberGroupRepository interface {
 	FindMemberIDsByGroupID(ctx context.Context, groupID string) ([]string, error)
 
+	FindOne(ctx context.Context, groupID string, memberID string) (*model.MemberGroup, error)
+
+	IsMember(ctx context.Context, groupID string, memberID string) (bool, error)
+
+	FilterMemberIDs(ctx context.Context, groupID string, memberIDs []string) ([]string, error)
+
+	MarkRead(
+		ctx context.Context,
+		groupID string,
+		memberID string,
+		messageID string,
+		createdAt time.Time,
+	) (*model.MemberGroup, bool, error)
+
+	FindReaderIDs(
+		ctx context.Context,
+		groupID string,
+		messageID string,
+		createdAt time.Time,
+	) ([]string, error)
+
+	UnreadCounts(ctx context.Context, memberID string, maxCount int64) ([]UnreadCount, error)
+
+	SetMute(
+		ctx context.Context,
+		groupID string,
+		memberID string,
+		muted bool,
+		until *time.Time,
+	) (*model.MemberGroup, error)
+
+	FindNotifiableIDs(ctx context.Context, groupID string, memberIDs []string) ([]string, error)
+
 	CountMembersByGroupID(ctx context.Context, groupID string) (int64, error)
 
 	AddMembers(ctx context.Context, groupID string, memberIDs []string) error
 
 	RemoveMembers(ctx context.Context, groupID string, memberIDs []string) error
+
+	EnsureIndexes(ctx context.Context) error
 }
 
 type memberGroupRepo struct {
@@ -72,6 +113,270 @@ func (m *memberGroupRepo) FindMemberIDsByGroupID(
 	return ids, nil
 }
 
+// FindOne implements MemberGroupRepository.
+func (m *memberGroupRepo) FindOne(
+	ctx context.Context,
+	groupID string,
+	memberID string,
+) (*model.MemberGroup, error) {
+	filter := bson.M{
+		"group_id":  groupID,
+		"member_id": memberID,
+	}
+
+	memberGroup := model.MemberGroup{}
+	if err := m.coll.FindOne(ctx, filter).Decode(&memberGroup); err != nil {
+		return nil, err
+	}
+	return &memberGroup, nil
+}
+
+// IsMember implements MemberGroupRepository.
+func (m *memberGroupRepo) IsMember(
+	ctx context.Context,
+	groupID string,
+	memberID string,
+) (bool, error) {
+	filter := bson.M{
+		"group_id":  groupID,
+		"member_id": memberID,
+	}
+	opts := options.
+		FindOne().
+		SetProjection(bson.M{"id": 1})
+
+	err := m.coll.FindOne(ctx, filter, opts).Err()
+	if err == mongo.ErrNoDocuments {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// FilterMemberIDs implements MemberGroupRepository. It keeps the given
+// members who belong to the group.
+func (m *memberGroupRepo) FilterMemberIDs(
+	ctx context.Context,
+	groupID string,
+	memberIDs []string,
+) ([]string, error) {
+	filter := bson.M{
+		"group_id": groupID,
+		"member_id": bson.M{
+			"$in": memberIDs,
+		},
+	}
+	return m.findMemberIDs(ctx, filter)
+}
+
+func (m *memberGroupRepo) findMemberIDs(ctx context.Context, filter bson.M) ([]string, error) {
+	opts := options.Find().SetProjection(bson.M{"member_id": 1})
+
+	cursor, err := m.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	memberGroups := []model.MemberGroup{}
+	if err := cursor.All(ctx, &memberGroups); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(memberGroups))
+	for i, ele := range memberGroups {
+		ids[i] = ele.MemberID
+	}
+	return ids, nil
+}
+
+// MarkRead implements MemberGroupRepository. The read marker only moves
+// forward, to the message with the given ID and creation time; it reports
+// whether it moved. Non-members get mongo.ErrNoDocuments.
+func (m *memberGroupRepo) MarkRead(
+	ctx context.Context,
+	groupID string,
+	memberID string,
+	messageID string,
+	createdAt time.Time,
+) (*model.MemberGroup, bool, error) {
+	filter := bson.M{
+		"group_id":  groupID,
+		"member_id": memberID,
+		"$or": bson.A{
+			bson.M{"last_read_at": nil},
+			bson.M{"last_read_at": bson.M{"$lt": createdAt}},
+			bson.M{
+				"last_read_at":         createdAt,
+				"last_read_message_id": bson.M{"$lt": messageID},
+			},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"last_read_message_id": messageID,
+			"last_read_at":         createdAt,
+			"updated_at":           time.Now(),
+		},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	updatedDoc := model.MemberGroup{}
+	err := m.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updatedDoc)
+	if err == nil {
+		return &updatedDoc, true, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return nil, false, err
+	}
+
+	// not a member, or the marker is already further along
+	current, err := m.FindOne(ctx, groupID, memberID)
+	if err != nil {
+		return nil, false, err
+	}
+	return current, false, nil
+}
+
+// FindReaderIDs implements MemberGroupRepository. Readers are the members
+// whose read marker is at or past the given message.
+func (m *memberGroupRepo) FindReaderIDs(
+	ctx context.Context,
+	groupID string,
+	messageID string,
+	createdAt time.Time,
+) ([]string, error) {
+	filter := bson.M{
+		"group_id": groupID,
+		"$or": bson.A{
+			bson.M{"last_read_at": bson.M{"$gt": createdAt}},
+			bson.M{
+				"last_read_at":         createdAt,
+				"last_read_message_id": bson.M{"$gte": messageID},
+			},
+		},
+	}
+	return m.findMemberIDs(ctx, filter)
+}
+
+// UnreadCounts implements MemberGroupRepository. It counts, in a single
+// aggregation over all groups of the member, the top-level messages of
+// others past their read marker, or since they joined if they never read
+// anything. Counting stops at maxCount per group.
+func (m *memberGroupRepo) UnreadCounts(
+	ctx context.Context,
+	memberID string,
+	maxCount int64,
+) ([]UnreadCount, error) {
+	unread := bson.A{
+		bson.M{"$match": bson.M{
+			"$expr": bson.M{"$and": bson.A{
+				bson.M{"$eq": bson.A{"$group_id", "$$group_id"}},
+				bson.M{"$gte": bson.A{"$created_at", "$$read_at"}},
+			}},
+			"parent_id":         nil,
+			"deleted_at":        nil,
+			"sender_id":         bson.M{"$ne": memberID},
+			"moderation.action": bson.M{"$ne": model.NGFilterActionShadow},
+		}},
+		// messages sharing the marker's time are only unread past its ID
+		bson.M{"$match": bson.M{
+			"$expr": bson.M{"$or": bson.A{
+				bson.M{"$gt": bson.A{"$created_at", "$$read_at"}},
+				bson.M{"$gt": bson.A{"$id", "$$read_id"}},
+			}},
+		}},
+		bson.M{"$limit": maxCount},
+		bson.M{"$count": "count"},
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"member_id": memberID}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from": model.MessageCollectionName,
+			"let": bson.M{
+				"group_id": "$group_id",
+				"read_at":  bson.M{"$ifNull": bson.A{"$last_read_at", "$created_at"}},
+				"read_id":  bson.M{"$ifNull": bson.A{"$last_read_message_id", ""}},
+			},
+			"pipeline": unread,
+			"as":       "unread",
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":      0,
+			"group_id": 1,
+			"count": bson.M{"$ifNull": bson.A{
+				bson.M{"$arrayElemAt": bson.A{"$unread.count", 0}},
+				0,
+			}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "group_id", Value: 1}}}},
+	}
+
+	cursor, err := m.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	counts := []UnreadCount{}
+	err = cursor.All(ctx, &counts)
+	return counts, err
+}
+
+// SetMute implements MemberGroupRepository. Non-members get
+// mongo.ErrNoDocuments.
+func (m *memberGroupRepo) SetMute(
+	ctx context.Context,
+	groupID string,
+	memberID string,
+	muted bool,
+	until *time.Time,
+) (*model.MemberGroup, error) {
+	filter := bson.M{
+		"group_id":  groupID,
+		"member_id": memberID,
+	}
+
+	set := bson.M{"muted": muted, "updated_at": time.Now()}
+	update := bson.M{"$set": set}
+	if muted && until != nil {
+		set["muted_until"] = *until
+	} else {
+		update["$unset"] = bson.M{"muted_until": ""}
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	updatedDoc := model.MemberGroup{}
+	if err := m.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updatedDoc); err != nil {
+		return nil, err
+	}
+	return &updatedDoc, nil
+}
+
+// FindNotifiableIDs implements MemberGroupRepository. It keeps the given
+// members who belong to the group and have not muted it.
+func (m *memberGroupRepo) FindNotifiableIDs(
+	ctx context.Context,
+	groupID string,
+	memberIDs []string,
+) ([]string, error) {
+	filter := bson.M{
+		"group_id": groupID,
+		"member_id": bson.M{
+			"$in": memberIDs,
+		},
+		"$or": bson.A{
+			bson.M{"muted": bson.M{"$ne": true}},
+			bson.M{"muted_until": bson.M{"$lte": time.Now()}},
+		},
+	}
+	return m.findMemberIDs(ctx, filter)
+}
+
 // AddMembers implements MemberGroupRepository.
 func (m *memberGroupRepo) AddMembers(
 	ctx context.Context,
@@ -143,3 +448,20 @@ func (m *memberGroupRepo) RemoveMembers(
 	_, err := m.coll.DeleteMany(ctx, filter)
 	return err
 }
+
+// EnsureIndexes implements MemberGroupRepository.
+func (m *memberGroupRepo) EnsureIndexes(ctx context.Context) error {
+	_, err := m.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "group_id", Value: 1},
+				{Key: "member_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "member_id", Value: 1}},
+		},
+	})
+	return err
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/infrastructure/mongodb"
+	"github.com/noxhalley/funken/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type ReactionSummary struct {
+	Emoji string `bson:"_id"        json:"emoji"`
+	Count int64  `bson:"count"      json:"count"`
+	// MemberIDs come in the order the members reacted.
+	MemberIDs []string `bson:"member_ids" json:"member_ids"`
+}
+
+type MessageReactionRepository interface {
+	Add(
+		ctx context.Context,
+		reaction model.MessageReaction,
+	) (bool, error)
+
+	Remove(
+		ctx context.Context,
+		messageID string,
+		memberID string,
+		emoji string,
+	) (bool, error)
+
+	CountByEmoji(
+		ctx context.Context,
+		messageID string,
+		emoji string,
+	) (int64, error)
+
+	Summarize(
+		ctx context.Context,
+		messageID string,
+	) ([]ReactionSummary, error)
+
+	DeleteByMessageIDs(
+		ctx context.Context,
+		messageIDs []string,
+	) error
+
+	EnsureIndexes(ctx context.Context) error
+}
+
+type messageReactionRepo struct {
+	logger *log.Logger
+	coll   *mongo.Collection
+}
+
+func NewMessageReactionRepository(db *mongodb.MongoDB) MessageReactionRepository {
+	coll := db.Client.
+		Database(db.DBName).
+		Collection(model.MessageReactionCollectionName)
+
+	return &messageReactionRepo{
+		logger: log.With("repository", "message_reaction_repository"),
+		coll:   coll,
+	}
+}
+
+// Add implements MessageReactionRepository. It reports whether the
+// reaction is new; adding it again changes nothing. The unique index keeps
+// concurrent adds of the same reaction from both inserting.
+func (m *messageReactionRepo) Add(
+	ctx context.Context,
+	reaction model.MessageReaction,
+) (bool, error) {
+	filter := bson.M{
+		"message_id": reaction.MessageID,
+		"member_id":  reaction.MemberID,
+		"emoji":      reaction.Emoji,
+	}
+	update := bson.M{"$setOnInsert": reaction}
+	opts := options.UpdateOne().SetUpsert(true)
+
+	res, err := m.coll.UpdateOne(ctx, filter, update, opts)
+	if mongo.IsDuplicateKeyError(err) {
+		// lost the race against an identical add
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return res.UpsertedCount > 0, nil
+}
+
+// Remove implements MessageReactionRepository. It reports whether there
+// was a reaction to remove.
+func (m *messageReactionRepo) Remove(
+	ctx context.Context,
+	messageID string,
+	memberID string,
+	emoji string,
+) (bool, error) {
+	filter := bson.M{
+		"message_id": messageID,
+		"member_id":  memberID,
+		"emoji":      emoji,
+	}
+
+	res, err := m.coll.DeleteOne(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+	return res.DeletedCount > 0, nil
+}
+
+// CountByEmoji implements MessageReactionRepository.
+func (m *messageReactionRepo) CountByEmoji(
+	ctx context.Context,
+	messageID string,
+	emoji string,
+) (int64, error) {
+	filter := bson.M{"message_id": messageID, "emoji": emoji}
+	return m.coll.CountDocuments(ctx, filter)
+}
+
+// Summarize implements MessageReactionRepository. Emojis come in the order
+// they were first used on the message.
+func (m *messageReactionRepo) Summarize(
+	ctx context.Context,
+	messageID string,
+) ([]ReactionSummary, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"message_id": messageID}}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: "created_at", Value: 1},
+			{Key: "id", Value: 1},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":        "$emoji",
+			"count":      bson.M{"$sum": 1},
+			"member_ids": bson.M{"$push": "$member_id"},
+			"first_at":   bson.M{"$min": "$created_at"},
+		}}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: "first_at", Value: 1},
+			{Key: "_id", Value: 1},
+		}}},
+	}
+
+	cursor, err := m.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	summaries := []ReactionSummary{}
+	err = cursor.All(ctx, &summaries)
+	return summaries, err
+}
+
+// DeleteByMessageIDs implements MessageReactionRepository.
+func (m *messageReactionRepo) DeleteByMessageIDs(
+	ctx context.Context,
+	messageIDs []string,
+) error {
+	filter := bson.M{
+		"message_id": bson.M{
+			"$in": messageIDs,
+		},
+	}
+
+	_, err := m.coll.DeleteMany(ctx, filter)
+	return err
+}
+
+// EnsureIndexes implements MessageReactionRepository.
+func (m *messageReactionRepo) EnsureIndexes(ctx context.Context) error {
+	_, err := m.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "message_id", Value: 1},
+				{Key: "emoji", Value: 1},
+				{Key: "member_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+	})
+	return err
+}
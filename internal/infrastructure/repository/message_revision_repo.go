@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/infrastructure/mongodb"
+	"github.com/noxhalley/funken/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type MessageRevisionRepository interface {
+	Create(
+		ctx context.Context,
+		revision model.MessageRevision,
+	) error
+
+	ListByMessage(
+		ctx context.Context,
+		messageID string,
+	) ([]model.MessageRevision, error)
+
+	DeleteByID(
+		ctx context.Context,
+		ID string,
+	) error
+
+	DeleteByMessageIDs(
+		ctx context.Context,
+		messageIDs []string,
+	) error
+
+	EnsureIndexes(ctx context.Context) error
+}
+
+type messageRevisionRepo struct {
+	logger *log.Logger
+	coll   *mongo.Collection
+}
+
+func NewMessageRevisionRepository(db *mongodb.MongoDB) MessageRevisionRepository {
+	coll := db.Client.
+		Database(db.DBName).
+		Collection(model.MessageRevisionCollectionName)
+
+	return &messageRevisionRepo{
+		logger: log.With("repository", "message_revision_repository"),
+		coll:   coll,
+	}
+}
+
+// Create implements MessageRevisionRepository.
+func (m *messageRevisionRepo) Create(
+	ctx context.Context,
+	revision model.MessageRevision,
+) error {
+	_, err := m.coll.InsertOne(ctx, revision)
+	return err
+}
+
+// ListByMessage implements MessageRevisionRepository. Revisions come
+// oldest first.
+func (m *messageRevisionRepo) ListByMessage(
+	ctx context.Context,
+	messageID string,
+) ([]model.MessageRevision, error) {
+	filter := bson.M{"message_id": messageID}
+	opts := options.Find().SetSort(bson.D{
+		{Key: "created_at", Value: 1},
+		{Key: "id", Value: 1},
+	})
+
+	cursor, err := m.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	revisions := []model.MessageRevision{}
+	err = cursor.All(ctx, &revisions)
+	return revisions, err
+}
+
+// DeleteByID implements MessageRevisionRepository.
+func (m *messageRevisionRepo) DeleteByID(
+	ctx context.Context,
+	ID string,
+) error {
+	_, err := m.coll.DeleteOne(ctx, bson.M{"id": ID})
+	return err
+}
+
+// DeleteByMessageIDs implements MessageRevisionRepository.
+func (m *messageRevisionRepo) DeleteByMessageIDs(
+	ctx context.Context,
+	messageIDs []string,
+) error {
+	filter := bson.M{
+		"message_id": bson.M{
+			"$in": messageIDs,
+		},
+	}
+
+	_, err := m.coll.DeleteMany(ctx, filter)
+	return err
+}
+
+// EnsureIndexes implements MessageRevisionRepository.
+func (m *messageRevisionRepo) EnsureIndexes(ctx context.Context) error {
+	_, err := m.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "message_id", Value: 1},
+				{Key: "created_at", Value: 1},
+			},
+		},
+	})
+	return err
+}
@@ -2,6 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"maps"
+	"time"
 
 	"github.com/noxhalley/funken/internal/infrastructure/log"
 	"github.com/noxhalley/funken/internal/infrastructure/mongodb"
@@ -11,6 +14,76 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
+// WithDeleted lets a filter passed to the finders of MessageRepository
+// also match soft-deleted messages. Filters constraining deleted_at
+// themselves are taken as they are.
+func WithDeleted(filter bson.M) interface{} {
+	return withDeleted{filter: filter}
+}
+
+type withDeleted struct {
+	filter bson.M
+}
+
+type ListMessagesParams struct {
+	GroupID   string
+	Direction model.MsgSortDirection
+	// After is the keyset position to continue from, exclusive.
+	After *model.MessageCursor
+	Limit int64
+	// ViewerID also sees their own shadowed messages.
+	ViewerID string
+	// Flagged keeps only messages flagged for review.
+	Flagged bool
+	// IncludeDeleted also lists soft-deleted messages.
+	IncludeDeleted bool
+	// ThreadID lists the replies of that thread instead of the group's
+	// top-level messages.
+	ThreadID string
+}
+
+type ListThreadsParams struct {
+	GroupID string
+	// After is the keyset position to continue from, exclusive.
+	After *model.ThreadCursor
+	Limit int64
+	// ViewerID also sees their own shadowed threads.
+	ViewerID string
+}
+
+type SearchMessagesParams struct {
+	Query string
+	// GroupID, SenderID and Mention narrow the search when set.
+	GroupID  string
+	SenderID string
+	Mention  string
+	Priority *bool
+	// From and To bound the creation time, To being exclusive.
+	From *time.Time
+	To   *time.Time
+	Sort model.SearchSort
+	// After is the keyset position to continue from, exclusive.
+	After *model.SearchCursor
+	Limit int64
+	// ViewerID also finds their own shadowed messages.
+	ViewerID string
+}
+
+// ExpiredMessagesParams selects the top-level messages of a group which
+// fell out of its retention: the ones created before Before when set, and
+// the ones older than its Keep most recent visible ones when set.
+type ExpiredMessagesParams struct {
+	GroupID string
+	Before  *time.Time
+	Keep    int64
+	Limit   int64
+}
+
+type MessageSearchResult struct {
+	model.Message `bson:",inline"`
+	Score         float64 `bson:"score"`
+}
+
 type MessageRepository interface {
 	CountByConditions(
 		ctx context.Context,
@@ -41,10 +114,64 @@ type MessageRepository interface {
 		operation interface{},
 	) (*model.Message, error)
 
+	UpdateOneByConditions(
+		ctx context.Context,
+		filter interface{},
+		operation interface{},
+	) (*model.Message, error)
+
 	DeleteByID(
 		ctx context.Context,
 		ID string,
-	) error
+	) (*model.Message, error)
+
+	RestoreByID(
+		ctx context.Context,
+		ID string,
+	) (*model.Message, error)
+
+	FindDeletedIDs(
+		ctx context.Context,
+		before time.Time,
+		limit int64,
+	) ([]string, error)
+
+	HardDeleteByIDs(
+		ctx context.Context,
+		IDs []string,
+	) (int64, error)
+
+	HardDeleteLiveByIDs(
+		ctx context.Context,
+		IDs []string,
+	) (int64, error)
+
+	FindExpiredIDs(
+		ctx context.Context,
+		params ExpiredMessagesParams,
+	) ([]string, error)
+
+	ExpireByIDs(
+		ctx context.Context,
+		IDs []string,
+	) ([]model.Message, error)
+
+	ListByGroup(
+		ctx context.Context,
+		params ListMessagesParams,
+	) ([]model.Message, error)
+
+	ListThreads(
+		ctx context.Context,
+		params ListThreadsParams,
+	) ([]model.Message, error)
+
+	Search(
+		ctx context.Context,
+		params SearchMessagesParams,
+	) ([]MessageSearchResult, error)
+
+	EnsureIndexes(ctx context.Context) error
 }
 
 type messageRepo struct {
@@ -63,13 +190,33 @@ func NewMessageRepository(db *mongodb.MongoDB) MessageRepository {
 	}
 }
 
+// liveOnly scopes filter to messages which are not soft-deleted, unless it
+// says otherwise.
+func liveOnly(filter interface{}) interface{} {
+	switch f := filter.(type) {
+	case withDeleted:
+		return f.filter
+	case nil:
+		return bson.M{"deleted_at": nil}
+	case bson.M:
+		if _, ok := f["deleted_at"]; ok {
+			return f
+		}
+		scoped := maps.Clone(f)
+		scoped["deleted_at"] = nil
+		return scoped
+	default:
+		return bson.M{"$and": bson.A{f, bson.M{"deleted_at": nil}}}
+	}
+}
+
 // CountByConditions implements MessageRepository.
 func (m *messageRepo) CountByConditions(
 	ctx context.Context,
 	filter interface{},
 	opts *options.CountOptionsBuilder,
 ) (int64, error) {
-	return m.coll.CountDocuments(ctx, filter, opts)
+	return m.coll.CountDocuments(ctx, liveOnly(filter), opts)
 }
 
 // FindOneByConditions implements MessageRepository.
@@ -79,7 +226,7 @@ func (m *messageRepo) FindOneByConditions(
 	opts *options.FindOneOptionsBuilder,
 ) (*model.Message, error) {
 	msg := model.Message{}
-	if err := m.coll.FindOne(ctx, filter, opts).Decode(&msg); err != nil {
+	if err := m.coll.FindOne(ctx, liveOnly(filter), opts).Decode(&msg); err != nil {
 		return nil, err
 	}
 	return &msg, nil
@@ -91,7 +238,7 @@ func (m *messageRepo) FindByConditions(
 	filter interface{},
 	opts *options.FindOptionsBuilder,
 ) ([]model.Message, error) {
-	cursor, err := m.coll.Find(ctx, filter, opts)
+	cursor, err := m.coll.Find(ctx, liveOnly(filter), opts)
 	if err != nil {
 		return nil, err
 	}
@@ -127,9 +274,427 @@ func (m *messageRepo) UpdateByID(
 	return &updatedDoc, nil
 }
 
-// DeleteByID implements MessageRepository.
-func (m *messageRepo) DeleteByID(ctx context.Context, ID string) error {
-	filter := bson.M{"id": ID}
-	_, err := m.coll.DeleteOne(ctx, filter)
+// UpdateOneByConditions implements MessageRepository. Unlike the finders,
+// filter is not scoped to live messages.
+func (m *messageRepo) UpdateOneByConditions(
+	ctx context.Context,
+	filter interface{},
+	operation interface{},
+) (*model.Message, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	updatedDoc := model.Message{}
+	if err := m.coll.FindOneAndUpdate(ctx, filter, operation, opts).Decode(&updatedDoc); err != nil {
+		return nil, err
+	}
+	return &updatedDoc, nil
+}
+
+// DeleteByID implements MessageRepository. The message is only marked as
+// deleted, HardDeleteByIDs removes it for good later on. Deleting a message
+// twice fails with mongo.ErrNoDocuments.
+func (m *messageRepo) DeleteByID(ctx context.Context, ID string) (*model.Message, error) {
+	now := time.Now()
+	filter := bson.M{"id": ID, "deleted_at": nil}
+	update := bson.M{"$set": bson.M{"deleted_at": now, "updated_at": now}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	deletedDoc := model.Message{}
+	if err := m.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&deletedDoc); err != nil {
+		return nil, err
+	}
+	return &deletedDoc, nil
+}
+
+// RestoreByID implements MessageRepository. Restoring a message which is
+// not deleted fails with mongo.ErrNoDocuments.
+func (m *messageRepo) RestoreByID(ctx context.Context, ID string) (*model.Message, error) {
+	filter := bson.M{"id": ID, "deleted_at": bson.M{"$ne": nil}}
+	update := bson.M{
+		"$unset": bson.M{"deleted_at": ""},
+		"$set":   bson.M{"updated_at": time.Now()},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	restoredDoc := model.Message{}
+	if err := m.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&restoredDoc); err != nil {
+		return nil, err
+	}
+	return &restoredDoc, nil
+}
+
+// FindDeletedIDs implements MessageRepository. It returns the IDs of
+// messages soft-deleted before the given time, oldest deletions first.
+func (m *messageRepo) FindDeletedIDs(
+	ctx context.Context,
+	before time.Time,
+	limit int64,
+) ([]string, error) {
+	filter := bson.M{"deleted_at": bson.M{"$lt": before}}
+	opts := options.Find().
+		SetProjection(bson.M{"id": 1}).
+		SetSort(bson.D{{Key: "deleted_at", Value: 1}}).
+		SetLimit(limit)
+
+	messages, err := m.FindByConditions(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	IDs := make([]string, len(messages))
+	for i, msg := range messages {
+		IDs[i] = msg.ID
+	}
+	return IDs, nil
+}
+
+// HardDeleteByIDs implements MessageRepository. Unlike DeleteByID, the
+// messages are gone for good.
+func (m *messageRepo) HardDeleteByIDs(ctx context.Context, IDs []string) (int64, error) {
+	filter := bson.M{
+		"id": bson.M{
+			"$in": IDs,
+		},
+	}
+
+	res, err := m.coll.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
+
+// HardDeleteLiveByIDs implements MessageRepository. It is HardDeleteByIDs
+// for the messages which are not soft-deleted, so that callers know how
+// many of them still counted in their group.
+func (m *messageRepo) HardDeleteLiveByIDs(ctx context.Context, IDs []string) (int64, error) {
+	filter := bson.M{
+		"id": bson.M{
+			"$in": IDs,
+		},
+		"deleted_at": nil,
+	}
+
+	res, err := m.coll.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
+
+// FindExpiredIDs implements MessageRepository. Replies are left out, they
+// expire with their thread, and so are shadowed messages when counting the
+// ones to keep since the group never saw them.
+func (m *messageRepo) FindExpiredIDs(
+	ctx context.Context,
+	params ExpiredMessagesParams,
+) ([]string, error) {
+	filter := bson.M{"group_id": params.GroupID, "parent_id": nil}
+	if params.Before != nil {
+		filter["created_at"] = bson.M{"$lt": *params.Before}
+	}
+
+	if params.Keep > 0 {
+		kept := bson.M{"group_id": params.GroupID, "parent_id": nil}
+		visibleTo(kept, "")
+		opts := options.FindOne().
+			SetSort(bson.D{
+				{Key: "created_at", Value: -1},
+				{Key: "id", Value: -1},
+			}).
+			SetSkip(params.Keep - 1)
+
+		oldest, err := m.FindOneByConditions(ctx, kept, opts)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		filter["$or"] = bson.A{
+			bson.M{"created_at": bson.M{"$lt": oldest.CreatedAt}},
+			bson.M{
+				"created_at": oldest.CreatedAt,
+				"id":         bson.M{"$lt": oldest.ID},
+			},
+		}
+	}
+
+	opts := options.Find().
+		SetProjection(bson.M{"id": 1}).
+		SetSort(bson.D{
+			{Key: "created_at", Value: 1},
+			{Key: "id", Value: 1},
+		}).
+		SetLimit(params.Limit)
+
+	messages, err := m.FindByConditions(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	IDs := make([]string, len(messages))
+	for i, msg := range messages {
+		IDs[i] = msg.ID
+	}
+	return IDs, nil
+}
+
+// ExpireByIDs implements MessageRepository. The messages are soft-deleted
+// along with the replies of the ones starting a thread, so that no thread
+// outlives its first message, and HardDeleteByIDs removes them later on
+// like any deleted message. It returns only the messages this call deleted,
+// not those another caller deleted first.
+func (m *messageRepo) ExpireByIDs(ctx context.Context, IDs []string) ([]model.Message, error) {
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{"id": bson.M{"$in": IDs}},
+			bson.M{"parent_id": bson.M{"$in": IDs}},
+		},
+	}
+	messages, err := m.FindByConditions(ctx, filter, nil)
+	if err != nil || len(messages) == 0 {
+		return nil, err
+	}
+
+	expiredIDs := make([]string, len(messages))
+	for i, msg := range messages {
+		expiredIDs[i] = msg.ID
+	}
+
+	// deleted_at is stored with millisecond precision, so truncating now
+	// lets the messages this call deleted be told from the ones another
+	// replica deleted in the meantime
+	now := time.Now().Truncate(time.Millisecond)
+	filter = bson.M{"id": bson.M{"$in": expiredIDs}, "deleted_at": nil}
+	update := bson.M{"$set": bson.M{"deleted_at": now, "updated_at": now}}
+	res, err := m.coll.UpdateMany(ctx, filter, update)
+	if err != nil || res.ModifiedCount == 0 {
+		return nil, err
+	}
+
+	filter = bson.M{"id": bson.M{"$in": expiredIDs}, "deleted_at": now}
+	return m.FindByConditions(ctx, WithDeleted(filter), nil)
+}
+
+// visibleTo restricts filter to messages viewerID may see: shadowed
+// messages are only visible to their sender.
+func visibleTo(filter bson.M, viewerID string) {
+	notShadowed := bson.M{"moderation.action": bson.M{"$ne": model.NGFilterActionShadow}}
+	if viewerID == "" {
+		filter["moderation.action"] = notShadowed["moderation.action"]
+		return
+	}
+	filter["$and"] = bson.A{
+		bson.M{"$or": bson.A{notShadowed, bson.M{"sender_id": viewerID}}},
+	}
+}
+
+// ListByGroup implements MessageRepository. Replies are only listed within
+// their thread.
+func (m *messageRepo) ListByGroup(
+	ctx context.Context,
+	params ListMessagesParams,
+) ([]model.Message, error) {
+	filter := bson.M{"group_id": params.GroupID, "parent_id": nil}
+	if params.ThreadID != "" {
+		filter["parent_id"] = params.ThreadID
+	}
+
+	if params.Flagged {
+		filter["moderation.action"] = model.NGFilterActionFlag
+	} else {
+		visibleTo(filter, params.ViewerID)
+	}
+
+	order, cmp := -1, "$lt"
+	if params.Direction == model.MsgSortAsc {
+		order, cmp = 1, "$gt"
+	}
+
+	if params.After != nil {
+		filter["$or"] = bson.A{
+			bson.M{"created_at": bson.M{cmp: params.After.CreatedAt}},
+			bson.M{
+				"created_at": params.After.CreatedAt,
+				"id":         bson.M{cmp: params.After.ID},
+			},
+		}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{
+			{Key: "created_at", Value: order},
+			{Key: "id", Value: order},
+		}).
+		SetLimit(params.Limit)
+
+	if params.IncludeDeleted {
+		return m.FindByConditions(ctx, WithDeleted(filter), opts)
+	}
+	return m.FindByConditions(ctx, filter, opts)
+}
+
+// ListThreads implements MessageRepository. Threads are the top-level
+// messages with replies, latest activity first.
+func (m *messageRepo) ListThreads(
+	ctx context.Context,
+	params ListThreadsParams,
+) ([]model.Message, error) {
+	filter := bson.M{
+		"group_id":    params.GroupID,
+		"parent_id":   nil,
+		"reply_count": bson.M{"$gt": 0},
+		// lets the partial index serve the query
+		"last_reply_at": bson.M{"$exists": true},
+	}
+	visibleTo(filter, params.ViewerID)
+
+	if params.After != nil {
+		filter["$or"] = bson.A{
+			bson.M{"last_reply_at": bson.M{"$lt": params.After.LastReplyAt}},
+			bson.M{
+				"last_reply_at": params.After.LastReplyAt,
+				"id":            bson.M{"$lt": params.After.ID},
+			},
+		}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{
+			{Key: "last_reply_at", Value: -1},
+			{Key: "id", Value: -1},
+		}).
+		SetLimit(params.Limit)
+
+	return m.FindByConditions(ctx, filter, opts)
+}
+
+// Search implements MessageRepository. It goes through the text index, so
+// a query matches whole words, any of them unless quoted as a phrase, and
+// words prefixed with - exclude messages.
+func (m *messageRepo) Search(
+	ctx context.Context,
+	params SearchMessagesParams,
+) ([]MessageSearchResult, error) {
+	filter := bson.M{
+		"$text":      bson.M{"$search": params.Query},
+		"deleted_at": nil,
+	}
+	if params.GroupID != "" {
+		filter["group_id"] = params.GroupID
+	}
+	if params.SenderID != "" {
+		filter["sender_id"] = params.SenderID
+	}
+	if params.Mention != "" {
+		filter["mentions"] = params.Mention
+	}
+	if params.Priority != nil {
+		filter["priority"] = *params.Priority
+	}
+
+	createdAt := bson.M{}
+	if params.From != nil {
+		createdAt["$gte"] = *params.From
+	}
+	if params.To != nil {
+		createdAt["$lt"] = *params.To
+	}
+	if len(createdAt) > 0 {
+		filter["created_at"] = createdAt
+	}
+	visibleTo(filter, params.ViewerID)
+
+	sortKey := "score"
+	if params.Sort == model.SearchSortRecency {
+		sortKey = "created_at"
+	}
+
+	// the score only exists once $text matched, so the keyset goes in a
+	// second stage
+	after := bson.M{}
+	if params.After != nil {
+		var pos interface{} = params.After.Score
+		if sortKey == "created_at" {
+			pos = params.After.CreatedAt
+		}
+		after["$or"] = bson.A{
+			bson.M{sortKey: bson.M{"$lt": pos}},
+			bson.M{
+				sortKey: pos,
+				"id":    bson.M{"$lt": params.After.ID},
+			},
+		}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$addFields", Value: bson.M{"score": bson.M{"$meta": "textScore"}}}},
+		{{Key: "$match", Value: after}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: sortKey, Value: -1},
+			{Key: "id", Value: -1},
+		}}},
+		{{Key: "$limit", Value: params.Limit}},
+	}
+
+	cursor, err := m.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	results := []MessageSearchResult{}
+	err = cursor.All(ctx, &results)
+	return results, err
+}
+
+// EnsureIndexes implements MessageRepository.
+func (m *messageRepo) EnsureIndexes(ctx context.Context) error {
+	_, err := m.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "group_id", Value: 1},
+				{Key: "created_at", Value: -1},
+				{Key: "id", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "parent_id", Value: 1},
+				{Key: "created_at", Value: -1},
+				{Key: "id", Value: -1},
+			},
+			Options: options.Index().
+				SetPartialFilterExpression(bson.M{"parent_id": bson.M{"$exists": true}}),
+		},
+		{
+			Keys: bson.D{
+				{Key: "group_id", Value: 1},
+				{Key: "last_reply_at", Value: -1},
+				{Key: "id", Value: -1},
+			},
+			Options: options.Index().
+				SetPartialFilterExpression(bson.M{"last_reply_at": bson.M{"$exists": true}}),
+		},
+		{
+			// without a language, words are matched as they are written,
+			// which suits chats mixing many languages
+			Keys: bson.D{{Key: "message", Value: "text"}},
+			Options: options.Index().
+				SetName("message_text").
+				SetDefaultLanguage("none"),
+		},
+		{
+			Keys: bson.D{{Key: "deleted_at", Value: 1}},
+			Options: options.Index().
+				SetPartialFilterExpression(bson.M{"deleted_at": bson.M{"$exists": true}}),
+		},
+	})
 	return err
 }
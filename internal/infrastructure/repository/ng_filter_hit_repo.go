@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/noxhalley/funken/config"
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/infrastructure/mongodb"
+	"github.com/noxhalley/funken/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// indexOptionsConflict is the MongoDB error code for an index which exists
+// with other options.
+const indexOptionsConflict = 85
+
+// NGFilterHitGrouping is what hit statistics are counted per.
+type NGFilterHitGrouping string
+
+const (
+	NGFilterHitsByFilter NGFilterHitGrouping = "filter"
+	NGFilterHitsByGroup  NGFilterHitGrouping = "group"
+)
+
+// NGFilterHitBucket splits the window of hit statistics into time buckets.
+// The empty bucket counts the whole window at once.
+type NGFilterHitBucket string
+
+const (
+	NGFilterHitBucketHour NGFilterHitBucket = "hour"
+	NGFilterHitBucketDay  NGFilterHitBucket = "day"
+)
+
+type NGFilterHitStatsParams struct {
+	GroupID  string
+	FilterID string
+	// From and To bound the window, To being exclusive.
+	From   time.Time
+	To     time.Time
+	By     NGFilterHitGrouping
+	Bucket NGFilterHitBucket
+}
+
+type NGFilterHitStat struct {
+	// FilterID is empty when counting by group, GroupID when counting by
+	// filter, since global filters fire in many groups.
+	FilterID string
+	GroupID  string
+	// Bucket is the start of the time bucket, nil without buckets.
+	Bucket    *time.Time
+	Count     int64
+	LastHitAt time.Time
+}
+
+type NGFilterHitRepository interface {
+	FindByConditions(
+		ctx context.Context,
+		filter interface{},
+		opts *options.FindOptionsBuilder,
+	) ([]model.NGFilterHit, error)
+
+	CreateBatch(
+		ctx context.Context,
+		hits []model.NGFilterHit,
+	) error
+
+	Stats(
+		ctx context.Context,
+		params NGFilterHitStatsParams,
+	) ([]NGFilterHitStat, error)
+
+	EnsureIndexes(ctx context.Context) error
+}
+
+type ngFilterHitRepo struct {
+	logger *log.Logger
+	coll   *mongo.Collection
+	// retention is how long hits are kept before MongoDB expires them.
+	retention time.Duration
+}
+
+func NewNGFilterHitRepository(db *mongodb.MongoDB, cfg *config.Config) NGFilterHitRepository {
+	coll := db.Client.
+		Database(db.DBName).
+		Collection(model.NGFilterHitCollectionName)
+
+	return &ngFilterHitRepo{
+		logger:    log.With("repository", "ng_filter_hit_repository"),
+		coll:      coll,
+		retention: time.Duration(cfg.NGFilter.HitRetention) * time.Millisecond,
+	}
+}
+
+// FindByConditions implements NGFilterHitRepository.
+func (n *ngFilterHitRepo) FindByConditions(
+	ctx context.Context,
+	filter interface{},
+	opts *options.FindOptionsBuilder,
+) ([]model.NGFilterHit, error) {
+	cursor, err := n.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var hits []model.NGFilterHit
+	err = cursor.All(ctx, &hits)
+	return hits, err
+}
+
+// CreateBatch implements NGFilterHitRepository.
+func (n *ngFilterHitRepo) CreateBatch(
+	ctx context.Context,
+	hits []model.NGFilterHit,
+) error {
+	_, err := n.coll.InsertMany(ctx, hits)
+	return err
+}
+
+// Stats implements NGFilterHitRepository. Results are sorted by count,
+// the noisiest first; filters which did not fire are absent.
+func (n *ngFilterHitRepo) Stats(
+	ctx context.Context,
+	params NGFilterHitStatsParams,
+) ([]NGFilterHitStat, error) {
+	match := bson.M{
+		"created_at": bson.M{
+			"$gte": params.From,
+			"$lt":  params.To,
+		},
+	}
+	if params.GroupID != "" {
+		match["group_id"] = params.GroupID
+	}
+	if params.FilterID != "" {
+		match["filter_id"] = params.FilterID
+	}
+
+	key := bson.M{}
+	if params.By == NGFilterHitsByGroup {
+		key["group_id"] = "$group_id"
+	} else {
+		key["filter_id"] = "$filter_id"
+	}
+	if params.Bucket != "" {
+		key["bucket"] = bson.M{
+			"$dateTrunc": bson.M{
+				"date": "$created_at",
+				"unit": string(params.Bucket),
+			},
+		}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id":         key,
+			"count":       bson.M{"$sum": 1},
+			"last_hit_at": bson.M{"$max": "$created_at"},
+		}}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: "count", Value: -1},
+			{Key: "_id.bucket", Value: 1},
+		}}},
+	}
+
+	cursor, err := n.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		ID struct {
+			FilterID string     `bson:"filter_id"`
+			GroupID  string     `bson:"group_id"`
+			Bucket   *time.Time `bson:"bucket"`
+		} `bson:"_id"`
+		Count     int64     `bson:"count"`
+		LastHitAt time.Time `bson:"last_hit_at"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	stats := make([]NGFilterHitStat, len(rows))
+	for i, row := range rows {
+		stats[i] = NGFilterHitStat{
+			FilterID:  row.ID.FilterID,
+			GroupID:   row.ID.GroupID,
+			Bucket:    row.ID.Bucket,
+			Count:     row.Count,
+			LastHitAt: row.LastHitAt,
+		}
+	}
+	return stats, nil
+}
+
+// EnsureIndexes implements NGFilterHitRepository. Hits expire once the
+// retention is over, and a changed retention is applied to the existing
+// index rather than failing to create it again.
+func (n *ngFilterHitRepo) EnsureIndexes(ctx context.Context) error {
+	expireAfter := int32(n.retention / time.Second)
+	_, err := n.coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "group_id", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "filter_id", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+		},
+		{
+			Keys:    bson.D{{Key: "created_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(expireAfter),
+		},
+	})
+
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) && serverErr.HasErrorCode(indexOptionsConflict) {
+		cmd := bson.D{
+			{Key: "collMod", Value: n.coll.Name()},
+			{Key: "index", Value: bson.D{
+				{Key: "keyPattern", Value: bson.D{{Key: "created_at", Value: 1}}},
+				{Key: "expireAfterSeconds", Value: expireAfter},
+			}},
+		}
+		err = n.coll.Database().RunCommand(ctx, cmd).Err()
+	}
+	return err
+}
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// NATSStorage keeps files in a JetStream object store, which every replica
+// connected to the cluster shares.
+type NATSStorage struct {
+	store jetstream.ObjectStore
+}
+
+func NewNATSStorage(store jetstream.ObjectStore) *NATSStorage {
+	return &NATSStorage{store: store}
+}
+
+// Put implements Storage. The object store drops the chunks of an object
+// whose upload failed.
+func (n *NATSStorage) Put(ctx context.Context, key string, r io.Reader) error {
+	_, err := n.store.Put(ctx, jetstream.ObjectMeta{Name: key}, r)
+	return err
+}
+
+// Get implements Storage.
+func (n *NATSStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	obj, err := n.store.Get(ctx, key)
+	if errors.Is(err, jetstream.ErrObjectNotFound) {
+		return nil, ErrNotFound
+	}
+	return obj, err
+}
+
+// Delete implements Storage.
+func (n *NATSStorage) Delete(ctx context.Context, key string) error {
+	err := n.store.Delete(ctx, key)
+	if errors.Is(err, jetstream.ErrObjectNotFound) {
+		return nil
+	}
+	return err
+}
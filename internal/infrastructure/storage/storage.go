@@ -0,0 +1,33 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrNotFound = errors.New("object not found")
+
+// Storage keeps the content of uploaded files, under keys chosen by the
+// caller.
+type Storage interface {
+	// Put stores everything read from r under key. Nothing is stored when
+	// reading r fails.
+	Put(
+		ctx context.Context,
+		key string,
+		r io.Reader,
+	) error
+
+	// Get fails with ErrNotFound for unknown keys.
+	Get(
+		ctx context.Context,
+		key string,
+	) (io.ReadCloser, error)
+
+	// Delete succeeds for unknown keys.
+	Delete(
+		ctx context.Context,
+		key string,
+	) error
+}
@@ -2,14 +2,28 @@ package initializer
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/noxhalley/funken/config"
+	"github.com/noxhalley/funken/internal/auth"
+	"github.com/noxhalley/funken/internal/event"
+	"github.com/noxhalley/funken/internal/health"
 	"github.com/noxhalley/funken/internal/infrastructure/log"
 	"github.com/noxhalley/funken/internal/infrastructure/mongodb"
 	"github.com/noxhalley/funken/internal/infrastructure/pubsub"
 	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/infrastructure/storage"
+	"github.com/noxhalley/funken/internal/job"
+	"github.com/noxhalley/funken/internal/ngfilter"
+	"github.com/noxhalley/funken/internal/service"
+	"github.com/noxhalley/funken/internal/transport/rest"
+	"github.com/noxhalley/funken/internal/transport/rpc"
+	"github.com/noxhalley/funken/internal/transport/sse"
+	"github.com/noxhalley/funken/internal/transport/ws"
 
 	"go.uber.org/fx"
 )
@@ -37,21 +51,112 @@ func Build() fx.Option {
 		fx.Provide(
 			fx.Annotate(
 				jetstreamManager,
-				fx.As(
-					new(pubsub.Publisher),
-					new(pubsub.Subcriber),
-					new(pubsub.PubSub),
-					new(pubsub.StreamConsumerManager),
-					new(pubsub.PubSubStreamManager),
-				),
+				fx.As(new(pubsub.Publisher)),
+				fx.As(new(pubsub.Subcriber)),
+				fx.As(new(pubsub.PubSub)),
+				fx.As(new(pubsub.StreamConsumerManager)),
+				fx.As(new(pubsub.PubSubStreamManager)),
+				fx.As(new(pubsub.Watcher)),
+				fx.As(new(pubsub.HealthChecker)),
+				fx.As(new(pubsub.Broadcaster)),
+				fx.As(new(pubsub.ObjectStorer)),
 			),
 		),
+		fx.Invoke(ensureStreams),
+		fx.Provide(objectStorage),
+		fx.Provide(auth.NewTokenAuthenticator),
+		fx.Provide(health.NewChecker),
 
 		// repositories
 		fx.Provide(repository.NewGroupRepository),
 		fx.Provide(repository.NewMemberGroupRepository),
 		fx.Provide(repository.NewGroupNGFilterRepository),
 		fx.Provide(repository.NewMessageRepository),
+		fx.Provide(repository.NewMessageRevisionRepository),
+		fx.Provide(repository.NewMessageReactionRepository),
+		fx.Provide(repository.NewNGFilterHitRepository),
+		fx.Provide(repository.NewAttachmentRepository),
+		fx.Decorate(decorateNGFilterRepository),
+		fx.Invoke(ensureIndexes),
+
+		// services
+		fx.Provide(
+			ngfilter.NewNormalizer,
+			ngfilter.NewValidator,
+			ngfilter.NewCache,
+			ngfilter.NewInvalidator,
+			ngfilter.NewEngine,
+			ngfilter.NewTransfer,
+		),
+		fx.Invoke(listenNGFilterInvalidations),
+		fx.Provide(service.NewMessageService),
+
+		// transports
+		fx.Provide(
+			asRestHandler(rest.NewHealthHandler),
+			asRestHandler(rest.NewGroupHandler),
+			asRestHandler(rest.NewMessageHandler),
+			asRestHandler(rest.NewAttachmentHandler),
+			asRestHandler(wsGateway),
+			asRestHandler(sse.NewHandler),
+		),
+		fx.Provide(
+			fx.Annotate(
+				httpServer,
+				fx.ParamTags(``, ``, `group:"rest_handlers"`),
+			),
+		),
+		fx.Invoke(func(*rest.Server) {}),
+		fx.Provide(
+			asGRPCService(rpc.NewGroupServer),
+			asGRPCService(rpc.NewMemberGroupServer),
+			asGRPCService(rpc.NewMessageServer),
+			asGRPCService(rpc.NewNGFilterServer),
+		),
+		fx.Provide(
+			fx.Annotate(
+				grpcServer,
+				fx.ParamTags(``, ``, `group:"grpc_services"`),
+			),
+		),
+		fx.Invoke(func(*rpc.Server) {}),
+
+		// background jobs
+		fx.Provide(
+			asJob(job.NewMessagePurger),
+			asJob(job.NewRetentionEnforcer),
+			asJob(job.NewAttachmentPurger),
+		),
+		fx.Invoke(
+			fx.Annotate(
+				runJobs,
+				fx.ParamTags(``, `group:"jobs"`),
+			),
+		),
+	)
+}
+
+func asGRPCService(f any) any {
+	return fx.Annotate(
+		f,
+		fx.As(new(rpc.Service)),
+		fx.ResultTags(`group:"grpc_services"`),
+	)
+}
+
+func asRestHandler(f any) any {
+	return fx.Annotate(
+		f,
+		fx.As(new(rest.Handler)),
+		fx.ResultTags(`group:"rest_handlers"`),
+	)
+}
+
+func asJob(f any) any {
+	return fx.Annotate(
+		f,
+		fx.As(new(job.Job)),
+		fx.ResultTags(`group:"jobs"`),
 	)
 }
 
@@ -81,3 +186,170 @@ func jetstreamManager(lc fx.Lifecycle, cfg *config.Config) *pubsub.JetStreamMana
 	})
 	return jsm
 }
+
+// objectStorage picks the storage backend of attachments.
+func objectStorage(cfg *config.Config, objectStorer pubsub.ObjectStorer) (storage.Storage, error) {
+	switch cfg.Storage.Backend {
+	case "local":
+		return storage.NewLocalStorage(cfg.Storage.LocalPath)
+	case "nats":
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.JetStream.Timeout)*time.Second)
+		defer cancel()
+
+		store, err := objectStorer.EnsureObjectStore(ctx, cfg.Storage.NATSBucket)
+		if err != nil {
+			return nil, err
+		}
+		return storage.NewNATSStorage(store), nil
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.Storage.Backend)
+	}
+}
+
+type indexer interface {
+	EnsureIndexes(ctx context.Context) error
+}
+
+type indexParams struct {
+	fx.In
+	GroupRepo           repository.GroupRepository
+	MemberGroupRepo     repository.MemberGroupRepository
+	NGFilterRepo        repository.GroupNGFilterRepository
+	MessageRepo         repository.MessageRepository
+	MessageRevisionRepo repository.MessageRevisionRepository
+	MessageReactionRepo repository.MessageReactionRepository
+	NGFilterHitRepo     repository.NGFilterHitRepository
+	AttachmentRepo      repository.AttachmentRepository
+}
+
+func ensureIndexes(lc fx.Lifecycle, p indexParams) {
+	indexers := []indexer{
+		p.GroupRepo,
+		p.MemberGroupRepo,
+		p.NGFilterRepo,
+		p.MessageRepo,
+		p.MessageRevisionRepo,
+		p.MessageReactionRepo,
+		p.NGFilterHitRepo,
+		p.AttachmentRepo,
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			for _, idx := range indexers {
+				if err := idx.EnsureIndexes(ctx); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
+
+func ensureStreams(lc fx.Lifecycle, jsm pubsub.StreamConsumerManager) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			if err := jsm.EnsureStream(ctx, event.GroupStream, event.GroupStreamSubjects()); err != nil {
+				return err
+			}
+			return jsm.EnsureStream(ctx, event.MemberStream, event.MemberStreamSubjects())
+		},
+	})
+}
+
+// decorateNGFilterRepository validates filters before they are written and
+// invalidates the cached sets once they are.
+func decorateNGFilterRepository(
+	repo repository.GroupNGFilterRepository,
+	invalidator *ngfilter.Invalidator,
+	validator *ngfilter.Validator,
+) repository.GroupNGFilterRepository {
+	return ngfilter.NewValidatingRepository(ngfilter.NewInvalidatingRepository(repo, invalidator), validator)
+}
+
+// runJobs starts every background job with the application and stops them
+// with it, waiting for each to return.
+func runJobs(lc fx.Lifecycle, jobs []job.Job) {
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			for _, j := range jobs {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					j.Run(ctx)
+				}()
+			}
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+
+			done := make(chan struct{})
+			go func() {
+				wg.Wait()
+				close(done)
+			}()
+
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+}
+
+func listenNGFilterInvalidations(lc fx.Lifecycle, invalidator *ngfilter.Invalidator) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go invalidator.Listen(ctx)
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+}
+
+func wsGateway(
+	lc fx.Lifecycle,
+	cfg *config.Config,
+	authn auth.Authenticator,
+	memberGroupRepo repository.MemberGroupRepository,
+	messageSvc service.MessageService,
+	watcher pubsub.Watcher,
+) *ws.Gateway {
+	gw := ws.NewGateway(cfg, authn, memberGroupRepo, messageSvc, watcher)
+
+	lc.Append(fx.Hook{
+		OnStop: gw.Close,
+	})
+	return gw
+}
+
+func httpServer(lc fx.Lifecycle, cfg *config.Config, handlers []rest.Handler) *rest.Server {
+	srv := rest.NewServer(cfg, handlers)
+
+	lc.Append(fx.Hook{
+		OnStart: srv.Start,
+		OnStop:  srv.Stop,
+	})
+	return srv
+}
+
+func grpcServer(lc fx.Lifecycle, cfg *config.Config, services []rpc.Service) *rpc.Server {
+	srv := rpc.NewServer(cfg, services)
+
+	lc.Append(fx.Hook{
+		OnStart: srv.Start,
+		OnStop:  srv.Stop,
+	})
+	return srv
+}
@@ -0,0 +1,126 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/noxhalley/funken/config"
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/infrastructure/storage"
+	"github.com/noxhalley/funken/internal/model"
+)
+
+// AttachmentPurger deletes the attachments which were uploaded but never
+// sent with a message. It runs as often as MessagePurger.
+type AttachmentPurger struct {
+	logger         *log.Logger
+	attachmentRepo repository.AttachmentRepository
+	storage        storage.Storage
+	ttl            time.Duration
+	interval       time.Duration
+}
+
+func NewAttachmentPurger(
+	cfg *config.Config,
+	attachmentRepo repository.AttachmentRepository,
+	storage storage.Storage,
+) (*AttachmentPurger, error) {
+	interval, err := tickInterval("MSG_PURGE_INTERVAL", cfg.Purge.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AttachmentPurger{
+		logger:         log.With("job", "attachment_purger"),
+		attachmentRepo: attachmentRepo,
+		storage:        storage,
+		ttl:            time.Duration(cfg.Attachment.UnclaimedTTL) * time.Millisecond,
+		interval:       interval,
+	}, nil
+}
+
+// Run implements Job.
+func (p *AttachmentPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *AttachmentPurger) purge(ctx context.Context) {
+	before := time.Now().Add(-p.ttl)
+
+	var purged int
+	for ctx.Err() == nil {
+		attachments, err := p.attachmentRepo.FindUnclaimed(ctx, before, purgeBatchSize)
+		var n int
+		if err == nil && len(attachments) > 0 {
+			n, err = p.purgeUnclaimed(ctx, attachments)
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				p.logger.Error(ctx, "failed to purge unsent attachments", "error", err)
+			}
+			break
+		}
+
+		purged += n
+		if len(attachments) < purgeBatchSize {
+			break
+		}
+	}
+
+	if purged > 0 {
+		p.logger.Info(ctx, "purged unsent attachments", "count", purged, "uploaded_before", before)
+	}
+}
+
+// purgeUnclaimed deletes the metadata of attachments before their content,
+// since a message may claim one of them until then. Content left behind by
+// a failure is only logged, nothing refers to it anymore.
+func (p *AttachmentPurger) purgeUnclaimed(ctx context.Context, attachments []model.Attachment) (int, error) {
+	IDs := make([]string, len(attachments))
+	for i, attachment := range attachments {
+		IDs[i] = attachment.ID
+	}
+
+	deleted, err := p.attachmentRepo.DeleteUnclaimed(ctx, IDs)
+	for _, ID := range deleted {
+		if err := p.storage.Delete(ctx, ID); err != nil {
+			p.logger.Warn(ctx, "failed to delete attachment content", "attachment_id", ID, "error", err)
+		}
+	}
+	return len(deleted), err
+}
+
+// deleteAttachments deletes the content of attachments before their
+// metadata, so that no content is left behind by a failure. The attachments
+// must belong to messages, unclaimed ones go through DeleteUnclaimed.
+func deleteAttachments(
+	ctx context.Context,
+	storage storage.Storage,
+	attachmentRepo repository.AttachmentRepository,
+	attachments []model.Attachment,
+) error {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	IDs := make([]string, len(attachments))
+	for i, attachment := range attachments {
+		if err := storage.Delete(ctx, attachment.ID); err != nil {
+			return err
+		}
+		IDs[i] = attachment.ID
+	}
+	return attachmentRepo.DeleteByIDs(ctx, IDs)
+}
@@ -0,0 +1,22 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Job is a background task started with the application. Run returns once
+// ctx is done.
+type Job interface {
+	Run(ctx context.Context)
+}
+
+// tickInterval converts the interval a job runs at, configured in milliseconds
+// under name, rejecting values a ticker cannot run on.
+func tickInterval(name string, ms int) (time.Duration, error) {
+	if ms <= 0 {
+		return 0, fmt.Errorf("%s must be positive, got %d", name, ms)
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
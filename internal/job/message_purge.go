@@ -0,0 +1,167 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/noxhalley/funken/config"
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/infrastructure/storage"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const purgeBatchSize = 500
+
+// MessagePurger hard-deletes soft-deleted messages, with their replies,
+// revisions, reactions and attachments, once their grace period is over.
+// Every replica runs it, which is harmless since purging the same messages
+// twice deletes nothing the second time.
+type MessagePurger struct {
+	logger              *log.Logger
+	groupRepo           repository.GroupRepository
+	messageRepo         repository.MessageRepository
+	messageRevisionRepo repository.MessageRevisionRepository
+	messageReactionRepo repository.MessageReactionRepository
+	attachmentRepo      repository.AttachmentRepository
+	storage             storage.Storage
+	gracePeriod         time.Duration
+	interval            time.Duration
+}
+
+func NewMessagePurger(
+	cfg *config.Config,
+	groupRepo repository.GroupRepository,
+	messageRepo repository.MessageRepository,
+	messageRevisionRepo repository.MessageRevisionRepository,
+	messageReactionRepo repository.MessageReactionRepository,
+	attachmentRepo repository.AttachmentRepository,
+	storage storage.Storage,
+) (*MessagePurger, error) {
+	interval, err := tickInterval("MSG_PURGE_INTERVAL", cfg.Purge.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessagePurger{
+		logger:              log.With("job", "message_purger"),
+		groupRepo:           groupRepo,
+		messageRepo:         messageRepo,
+		messageRevisionRepo: messageRevisionRepo,
+		messageReactionRepo: messageReactionRepo,
+		attachmentRepo:      attachmentRepo,
+		storage:             storage,
+		gracePeriod:         time.Duration(cfg.Purge.GracePeriod) * time.Millisecond,
+		interval:            interval,
+	}, nil
+}
+
+// Run implements Job.
+func (p *MessagePurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *MessagePurger) purge(ctx context.Context) {
+	before := time.Now().Add(-p.gracePeriod)
+
+	var purged int64
+	for ctx.Err() == nil {
+		IDs, err := p.messageRepo.FindDeletedIDs(ctx, before, purgeBatchSize)
+		var n int64
+		if err == nil && len(IDs) > 0 {
+			n, err = p.purgeMessages(ctx, IDs)
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				p.logger.Error(ctx, "failed to purge deleted messages", "error", err)
+			}
+			break
+		}
+
+		purged += n
+		if len(IDs) < purgeBatchSize {
+			break
+		}
+	}
+
+	if purged > 0 {
+		p.logger.Info(ctx, "purged deleted messages", "count", purged, "deleted_before", before)
+	}
+}
+
+// purgeMessages hard-deletes the given messages along with the replies of
+// the ones starting a thread, so that no reply outlives its thread.
+func (p *MessagePurger) purgeMessages(ctx context.Context, IDs []string) (int64, error) {
+	filter := repository.WithDeleted(bson.M{"parent_id": bson.M{"$in": IDs}})
+	opts := options.Find().SetProjection(bson.M{"id": 1, "group_id": 1, "deleted_at": 1})
+	replies, err := p.messageRepo.FindByConditions(ctx, filter, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	// replies which were not deleted still count in their group
+	live := make(map[string][]string)
+	for _, reply := range replies {
+		IDs = append(IDs, reply.ID)
+		if reply.DeletedAt == nil {
+			live[reply.GroupID] = append(live[reply.GroupID], reply.ID)
+		}
+	}
+
+	// revisions, reactions and attachments go first, so none is left
+	// behind by a failure
+	if err := p.messageRevisionRepo.DeleteByMessageIDs(ctx, IDs); err != nil {
+		return 0, err
+	}
+	if err := p.messageReactionRepo.DeleteByMessageIDs(ctx, IDs); err != nil {
+		return 0, err
+	}
+	if err := p.purgeAttachments(ctx, IDs); err != nil {
+		return 0, err
+	}
+
+	// live replies are deleted on their own, so that only those this
+	// replica deleted are taken off the count of their group
+	var purged int64
+	for groupID, replyIDs := range live {
+		n, err := p.messageRepo.HardDeleteLiveByIDs(ctx, replyIDs)
+		if err != nil {
+			return 0, err
+		}
+		purged += n
+		if n == 0 {
+			continue
+		}
+
+		inc := bson.M{"$inc": bson.M{"message_count": -n}}
+		if _, err := p.groupRepo.UpdateByID(ctx, groupID, inc); err != nil {
+			p.logger.Warn(ctx, "failed to update message count", "group_id", groupID, "error", err)
+		}
+	}
+
+	n, err := p.messageRepo.HardDeleteByIDs(ctx, IDs)
+	if err != nil {
+		return 0, err
+	}
+	return purged + n, nil
+}
+
+func (p *MessagePurger) purgeAttachments(ctx context.Context, messageIDs []string) error {
+	attachments, err := p.attachmentRepo.FindByMessageIDs(ctx, messageIDs)
+	if err != nil {
+		return err
+	}
+	return deleteAttachments(ctx, p.storage, p.attachmentRepo, attachments)
+}
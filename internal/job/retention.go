@@ -0,0 +1,148 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/noxhalley/funken/config"
+	"github.com/noxhalley/funken/internal/event"
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/infrastructure/pubsub"
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+const retentionBatchSize = 500
+
+// RetentionEnforcer deletes the messages which fell out of the retention of
+// their group, each group going by its own. They are soft-deleted, so they
+// stay restorable until MessagePurger removes them once its grace period is
+// over. Like the purger, every replica runs it.
+type RetentionEnforcer struct {
+	logger      *log.Logger
+	groupRepo   repository.GroupRepository
+	messageRepo repository.MessageRepository
+	publisher   pubsub.Publisher
+	interval    time.Duration
+}
+
+func NewRetentionEnforcer(
+	cfg *config.Config,
+	groupRepo repository.GroupRepository,
+	messageRepo repository.MessageRepository,
+	publisher pubsub.Publisher,
+) (*RetentionEnforcer, error) {
+	interval, err := tickInterval("MSG_RETENTION_INTERVAL", cfg.Retention.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RetentionEnforcer{
+		logger:      log.With("job", "retention_enforcer"),
+		groupRepo:   groupRepo,
+		messageRepo: messageRepo,
+		publisher:   publisher,
+		interval:    interval,
+	}, nil
+}
+
+// Run implements Job.
+func (e *RetentionEnforcer) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		e.enforce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *RetentionEnforcer) enforce(ctx context.Context) {
+	filter := bson.M{
+		"retention.kind": bson.M{
+			"$in": bson.A{model.RetentionDays, model.RetentionMessages},
+		},
+	}
+
+	groups, err := e.groupRepo.FindByConditions(ctx, filter, nil)
+	if err != nil {
+		if ctx.Err() == nil {
+			e.logger.Error(ctx, "failed to find groups with a retention", "error", err)
+		}
+		return
+	}
+
+	for _, group := range groups {
+		if ctx.Err() != nil {
+			return
+		}
+		e.enforceGroup(ctx, group.ID, *group.Retention)
+	}
+}
+
+func (e *RetentionEnforcer) enforceGroup(ctx context.Context, groupID string, retention model.Retention) {
+	params := repository.ExpiredMessagesParams{
+		GroupID: groupID,
+		Limit:   retentionBatchSize,
+	}
+	switch retention.Kind {
+	case model.RetentionDays:
+		before := time.Now().AddDate(0, 0, -retention.Value)
+		params.Before = &before
+	case model.RetentionMessages:
+		params.Keep = int64(retention.Value)
+	}
+
+	var expired int64
+	for ctx.Err() == nil {
+		IDs, err := e.messageRepo.FindExpiredIDs(ctx, params)
+		var messages []model.Message
+		if err == nil && len(IDs) > 0 {
+			messages, err = e.messageRepo.ExpireByIDs(ctx, IDs)
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				e.logger.Error(ctx, "failed to expire messages", "group_id", groupID, "error", err)
+			}
+			break
+		}
+
+		// clients learn about expired messages like about deleted ones;
+		// ExpireByIDs only returns the messages this replica expired, so
+		// neither the events nor the count are doubled when replicas race
+		for i := range messages {
+			if !messages[i].IsShadowed() {
+				evt := event.NewMessage(event.MessageDeleted, &messages[i])
+				e.publish(ctx, evt, jetstream.WithMsgID(messages[i].ID+".expired"))
+			}
+		}
+
+		expired += int64(len(messages))
+		if len(IDs) < retentionBatchSize {
+			break
+		}
+	}
+
+	if expired == 0 {
+		return
+	}
+
+	inc := bson.M{"$inc": bson.M{"message_count": -expired}}
+	if _, err := e.groupRepo.UpdateByID(ctx, groupID, inc); err != nil {
+		e.logger.Warn(ctx, "failed to update message count", "group_id", groupID, "error", err)
+	}
+	e.logger.Info(ctx, "expired messages", "group_id", groupID, "count", expired, "retention", retention.Kind)
+}
+
+func (e *RetentionEnforcer) publish(ctx context.Context, evt event.Event, opts ...jetstream.PublishOpt) {
+	if _, err := e.publisher.Publish(ctx, evt.Subject(), evt, nil, opts...); err != nil {
+		e.logger.Warn(ctx, "failed to publish event", "type", evt.Type, "group_id", evt.GroupID, "error", err)
+	}
+}
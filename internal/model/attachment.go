@@ -0,0 +1,18 @@
+package model
+
+const AttachmentCollectionName = "attachments"
+
+// Attachment is a file uploaded to a group, which becomes part of the
+// message it is sent with.
+type Attachment struct {
+	BaseModel  `bson:",inline"             json:",inline"`
+	GroupID    string `bson:"group_id"             json:"group_id"`
+	UploaderID string `bson:"uploader_id"          json:"uploader_id"`
+	// MessageID is empty until a message is sent with the attachment.
+	MessageID string `bson:"message_id,omitempty" json:"message_id,omitempty"`
+	Name      string `bson:"name"                 json:"name"`
+	Size      int64  `bson:"size"                 json:"size"`
+	MIMEType  string `bson:"mime_type"            json:"mime_type"`
+	// Checksum is the hex encoded SHA-256 of the content.
+	Checksum string `bson:"checksum"             json:"checksum"`
+}
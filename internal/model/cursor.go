@@ -0,0 +1,137 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var ErrInvalidCursor = errors.New("cursor is invalid")
+
+// MessageCursor is a keyset position in a group's message history.
+// Direction is the order pages are returned in and is part of the cursor so
+// that clients need not track it. A Backward cursor pages towards the start
+// of that order, which is how a prev cursor goes back.
+type MessageCursor struct {
+	GroupID   string           `json:"g"`
+	CreatedAt time.Time        `json:"t"`
+	ID        string           `json:"i"`
+	Direction MsgSortDirection `json:"d"`
+	Backward  bool             `json:"b,omitempty"`
+}
+
+func NewMessageCursor(msg Message, direction MsgSortDirection, backward bool) *MessageCursor {
+	return &MessageCursor{
+		GroupID:   msg.GroupID,
+		CreatedAt: msg.CreatedAt,
+		ID:        msg.ID,
+		Direction: direction,
+		Backward:  backward,
+	}
+}
+
+// Encode returns the opaque string representation handed to clients.
+func (c MessageCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeMessageCursor(s string) (*MessageCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	c := MessageCursor{}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if c.GroupID == "" || c.ID == "" || c.CreatedAt.IsZero() || !c.Direction.IsValid() {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// ThreadCursor is a keyset position in a group's threads, which are always
+// listed by latest activity first.
+type ThreadCursor struct {
+	LastReplyAt time.Time `json:"t"`
+	ID          string    `json:"i"`
+}
+
+func NewThreadCursor(root Message) *ThreadCursor {
+	c := &ThreadCursor{ID: root.ID}
+	if root.LastReplyAt != nil {
+		c.LastReplyAt = *root.LastReplyAt
+	}
+	return c
+}
+
+// Encode returns the opaque string representation handed to clients.
+func (c ThreadCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeThreadCursor(s string) (*ThreadCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	c := ThreadCursor{}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if c.ID == "" || c.LastReplyAt.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// SearchSort ranks message search results.
+type SearchSort string
+
+const (
+	SearchSortRelevance SearchSort = "relevance"
+	SearchSortRecency   SearchSort = "recency"
+)
+
+func (s SearchSort) IsValid() bool {
+	return s == SearchSortRelevance || s == SearchSortRecency
+}
+
+// SearchCursor is a keyset position in message search results. Score is
+// only used when ranking by relevance, CreatedAt only by recency.
+type SearchCursor struct {
+	Sort      SearchSort `json:"s"`
+	Score     float64    `json:"r,omitempty"`
+	CreatedAt time.Time  `json:"t"`
+	ID        string     `json:"i"`
+}
+
+// Encode returns the opaque string representation handed to clients.
+func (c SearchCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeSearchCursor(s string) (*SearchCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	c := SearchCursor{}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if c.ID == "" || !c.Sort.IsValid() {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
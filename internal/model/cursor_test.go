@@ -0,0 +1,136 @@
+package model
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+)
+
+func encodeRaw(s string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
+
+func TestMessageCursorRoundTrip(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 30, 0, 123000000, time.UTC)
+	tests := []struct {
+		name   string
+		cursor MessageCursor
+	}{
+		{"forward", MessageCursor{GroupID: "g", CreatedAt: at, ID: "m", Direction: MsgSortDesc}},
+		{"backward", MessageCursor{GroupID: "g", CreatedAt: at, ID: "m", Direction: MsgSortAsc, Backward: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeMessageCursor(tt.cursor.Encode())
+			if err != nil {
+				t.Fatalf("DecodeMessageCursor(%v) = %v", tt.cursor, err)
+			}
+			if *got != tt.cursor {
+				t.Errorf("DecodeMessageCursor(%v) = %v", tt.cursor, *got)
+			}
+		})
+	}
+}
+
+func TestDecodeMessageCursorRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"empty", ""},
+		{"not base64", "!!"},
+		{"not json", encodeRaw("cursor")},
+		{"no group", encodeRaw(`{"t":"2026-03-01T12:30:00Z","i":"m","d":"asc"}`)},
+		{"no id", encodeRaw(`{"g":"g","t":"2026-03-01T12:30:00Z","d":"asc"}`)},
+		{"no time", encodeRaw(`{"g":"g","i":"m","d":"asc"}`)},
+		{"bad direction", encodeRaw(`{"g":"g","t":"2026-03-01T12:30:00Z","i":"m","d":"up"}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeMessageCursor(tt.cursor); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("DecodeMessageCursor(%q) = %v, want %v", tt.cursor, err, ErrInvalidCursor)
+			}
+		})
+	}
+}
+
+func TestThreadCursorRoundTrip(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+	cursor := *NewThreadCursor(Message{BaseModel: BaseModel{ID: "m"}, LastReplyAt: &at})
+
+	got, err := DecodeThreadCursor(cursor.Encode())
+	if err != nil {
+		t.Fatalf("DecodeThreadCursor(%v) = %v", cursor, err)
+	}
+	if *got != cursor {
+		t.Errorf("DecodeThreadCursor(%v) = %v", cursor, *got)
+	}
+}
+
+func TestDecodeThreadCursorRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"empty", ""},
+		{"not base64", "!!"},
+		{"not json", encodeRaw("[]")},
+		{"no id", encodeRaw(`{"t":"2026-03-01T12:30:00Z"}`)},
+		{"no reply", NewThreadCursor(Message{BaseModel: BaseModel{ID: "m"}}).Encode()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeThreadCursor(tt.cursor); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("DecodeThreadCursor(%q) = %v, want %v", tt.cursor, err, ErrInvalidCursor)
+			}
+		})
+	}
+}
+
+func TestSearchCursorRoundTrip(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		cursor SearchCursor
+	}{
+		{"relevance", SearchCursor{Sort: SearchSortRelevance, Score: 1.25, CreatedAt: at, ID: "m"}},
+		{"recency", SearchCursor{Sort: SearchSortRecency, CreatedAt: at, ID: "m"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeSearchCursor(tt.cursor.Encode())
+			if err != nil {
+				t.Fatalf("DecodeSearchCursor(%v) = %v", tt.cursor, err)
+			}
+			if *got != tt.cursor {
+				t.Errorf("DecodeSearchCursor(%v) = %v", tt.cursor, *got)
+			}
+		})
+	}
+}
+
+func TestDecodeSearchCursorRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"empty", ""},
+		{"not base64", "!!"},
+		{"not json", encodeRaw("{")},
+		{"no id", encodeRaw(`{"s":"recency","t":"2026-03-01T12:30:00Z"}`)},
+		{"bad sort", encodeRaw(`{"s":"random","i":"m"}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeSearchCursor(tt.cursor); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("DecodeSearchCursor(%q) = %v, want %v", tt.cursor, err, ErrInvalidCursor)
+			}
+		})
+	}
+}
@@ -1,9 +1,15 @@
 package model
 
-import "go.mongodb.org/mongo-driver/bson"
+import (
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
 
 type GroupStatus int8
 
+type RetentionKind string
+
 const (
 	GroupStatusActive GroupStatus = 1
 	GroupStatusLocked GroupStatus = 2
@@ -11,10 +17,89 @@ const (
 	GroupCollectionName = "groups"
 )
 
+const (
+	RetentionForever  RetentionKind = "forever"
+	RetentionDays     RetentionKind = "days"
+	RetentionMessages RetentionKind = "messages"
+)
+
+// Retention bounds how long a group keeps its messages: Value days, or its
+// Value most recent messages, not counting replies and shadowed messages.
+// Threads go with their first message. Groups without one keep them
+// forever.
+type Retention struct {
+	Kind  RetentionKind `bson:"kind"            json:"kind"`
+	Value int           `bson:"value,omitempty" json:"value,omitempty"`
+}
+
 type Group struct {
-	BaseModel    `bson:",inline"       json:",inline"`
-	Meta         bson.M      `bson:"meta,omitempty"         json:"meta"`
-	Status       GroupStatus `bson:"status"                 json:"status"`
-	MemberCount  *int        `bson:"member_count,omitempty" json:"member_count,omitempty"`
-	MessageCount int         `bson:"message_count"          json:"message_count"`
+	BaseModel       `bson:",inline"       json:",inline"`
+	Meta            bson.M      `bson:"meta,omitempty"               json:"meta"`
+	Status          GroupStatus `bson:"status"                       json:"status"`
+	MemberCount     *int        `bson:"member_count,omitempty"       json:"member_count,omitempty"`
+	MessageCount    int         `bson:"message_count"                json:"message_count"`
+	NGFilterOptOuts []string    `bson:"ng_filter_opt_outs,omitempty" json:"ng_filter_opt_outs,omitempty"`
+	Retention       *Retention  `bson:"retention,omitempty"          json:"retention,omitempty"`
+	// AttachmentPolicy, when set, replaces the server wide attachment
+	// limits for the group.
+	AttachmentPolicy *AttachmentPolicy `bson:"attachment_policy,omitempty" json:"attachment_policy,omitempty"`
+}
+
+// AttachmentPolicy restricts the files uploaded to a group. MaxSize is in
+// bytes, AllowedTypes are MIME types, "image/*" standing for every image
+// type. Zero fields fall back to the server defaults.
+type AttachmentPolicy struct {
+	MaxSize      int64    `bson:"max_size,omitempty"      json:"max_size,omitempty"`
+	AllowedTypes []string `bson:"allowed_types,omitempty" json:"allowed_types,omitempty"`
+}
+
+// maxGroupIDLength bounds the IDs clients choose for their groups.
+const maxGroupIDLength = 128
+
+// IsValidGroupID reports whether id may identify a group. Group IDs are
+// part of NATS subjects, so they are restricted to characters which have
+// no meaning there.
+func IsValidGroupID(id string) bool {
+	if id == "" || len(id) > maxGroupIDLength {
+		return false
+	}
+	for _, r := range id {
+		if !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9' || r == '_' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+func (s GroupStatus) IsValid() bool {
+	return s == GroupStatusActive || s == GroupStatusLocked
+}
+
+func (r Retention) IsValid() bool {
+	switch r.Kind {
+	case RetentionForever:
+		return r.Value == 0
+	case RetentionDays, RetentionMessages:
+		return r.Value > 0
+	default:
+		return false
+	}
+}
+
+// Expires reports whether the retention ever removes messages.
+func (r *Retention) Expires() bool {
+	return r != nil && r.Kind != RetentionForever
+}
+
+func (p AttachmentPolicy) IsValid() bool {
+	if p.MaxSize < 0 {
+		return false
+	}
+	for _, t := range p.AllowedTypes {
+		typ, subtype, ok := strings.Cut(t, "/")
+		if !ok || typ == "" || typ == "*" || subtype == "" || strings.ContainsAny(t, " ;") {
+			return false
+		}
+	}
+	return true
 }
@@ -1,11 +1,65 @@
 package model
 
-const GroupNGFilterCollectionName = "group_ng_filters"
+// NGFilterAction is what happens to a message matching a filter. The
+// constants are ordered from the weakest to the strongest action.
+type NGFilterAction string
+
+const (
+	// NGFilterActionFlag persists the message and flags it for review.
+	NGFilterActionFlag NGFilterAction = "flag"
+	// NGFilterActionMask replaces the matched spans with asterisks.
+	NGFilterActionMask NGFilterAction = "mask"
+	// NGFilterActionShadow persists the message visible to its sender only.
+	NGFilterActionShadow NGFilterAction = "shadow"
+	// NGFilterActionBlock rejects the message.
+	NGFilterActionBlock NGFilterAction = "block"
+
+	GroupNGFilterCollectionName = "group_ng_filters"
+)
 
 type GroupNGFilter struct {
 	BaseModel `bson:",inline"            json:",inline"`
-	GroupID   string `bson:"group_id,omitempty" json:"groupId"`
-	Title     string `bson:"title"              json:"title"`
-	Pattern   string `bson:"pattern"            json:"pattern"`
-	Flags     string `bson:"flags,omitempty"    json:"flags,omitempty"`
+	GroupID   string         `bson:"group_id,omitempty" json:"groupId"`
+	Title     string         `bson:"title"              json:"title"`
+	Pattern   string         `bson:"pattern"            json:"pattern"`
+	Flags     string         `bson:"flags,omitempty"    json:"flags,omitempty"`
+	Action    NGFilterAction `bson:"action,omitempty"   json:"action,omitempty"`
+}
+
+// IsGlobal reports whether f applies to every group rather than one.
+func (f GroupNGFilter) IsGlobal() bool {
+	return f.GroupID == ""
+}
+
+// EffectiveAction defaults filters stored before actions existed to block,
+// which is what every match used to do.
+func (f GroupNGFilter) EffectiveAction() NGFilterAction {
+	if f.Action == "" {
+		return NGFilterActionBlock
+	}
+	return f.Action
+}
+
+func (a NGFilterAction) IsValid() bool {
+	return a.rank() > 0
+}
+
+// Stronger reports whether a takes precedence over b.
+func (a NGFilterAction) Stronger(b NGFilterAction) bool {
+	return a.rank() > b.rank()
+}
+
+func (a NGFilterAction) rank() int {
+	switch a {
+	case NGFilterActionFlag:
+		return 1
+	case NGFilterActionMask:
+		return 2
+	case NGFilterActionShadow:
+		return 3
+	case NGFilterActionBlock:
+		return 4
+	default:
+		return 0
+	}
 }
@@ -0,0 +1,29 @@
+package model
+
+import "testing"
+
+func TestIsValidGroupID(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		want bool
+	}{
+		{"uuid", "0f8fad5b-d9cb-469f-a165-70867728950e", true},
+		{"word", "general_chat", true},
+		{"empty", "", false},
+		{"dot", "a.threads.b", false},
+		{"wildcard", "*", false},
+		{"full wildcard", ">", false},
+		{"space", "a b", false},
+		{"non ascii", "café", false},
+		{"too long", string(make([]byte, maxGroupIDLength+1)), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidGroupID(tt.id); got != tt.want {
+				t.Errorf("IsValidGroupID(%q) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,9 +1,19 @@
 package model
 
+import "time"
+
 const MemberGroupCollectionName = "member_groups"
 
 type MemberGroup struct {
 	BaseModel `bson:",inline"       json:",inline"`
 	MemberID  string `bson:"member_id"     json:"member_id"`
 	GroupID   string `bson:"group_id"      json:"group_id"`
+	// LastReadMessageID and LastReadAt mark how far the member has read
+	// the group, LastReadAt being the creation time of that message.
+	LastReadMessageID string     `bson:"last_read_message_id,omitempty" json:"last_read_message_id,omitempty"`
+	LastReadAt        *time.Time `bson:"last_read_at,omitempty"         json:"last_read_at,omitempty"`
+	// Muted silences notifications from the group, until MutedUntil if
+	// set.
+	Muted      bool       `bson:"muted,omitempty"       json:"muted"`
+	MutedUntil *time.Time `bson:"muted_until,omitempty" json:"muted_until,omitempty"`
 }
@@ -12,13 +12,42 @@ const (
 )
 
 type Message struct {
-	BaseModel `bson:",inline"              json:",inline"`
-	Message   string     `bson:"message"              json:"message"`
-	GroupID   string     `bson:"group_id,omitempty"   json:"group_id"`
-	SenderID  string     `bson:"sender_id,omitempty"  json:"sender_id"`
-	Mentions  []string   `bson:"mentions,omitempty"   json:"mentions"`
-	Priority  bool       `bson:"priority"             json:"priority"`
-	Nickname  string     `bson:"nickname"             json:"nickname"`
-	IPAddress string     `bson:"ip_address"           json:"ip_address"`
-	DeletedAt *time.Time `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+	BaseModel   `bson:",inline"                 json:",inline"`
+	Message     string       `bson:"message"                 json:"message"`
+	GroupID     string       `bson:"group_id,omitempty"      json:"group_id"`
+	SenderID    string       `bson:"sender_id,omitempty"     json:"sender_id"`
+	Mentions    []string     `bson:"mentions,omitempty"      json:"mentions"`
+	Priority    bool         `bson:"priority"                json:"priority"`
+	Nickname    string       `bson:"nickname"                json:"nickname"`
+	IPAddress   string       `bson:"ip_address"              json:"-"`
+	DeletedAt   *time.Time   `bson:"deleted_at,omitempty"    json:"deleted_at,omitempty"`
+	EditedAt    *time.Time   `bson:"edited_at,omitempty"     json:"edited_at,omitempty"`
+	ParentID    string       `bson:"parent_id,omitempty"     json:"parent_id,omitempty"`
+	ReplyCount  int64        `bson:"reply_count,omitempty"   json:"reply_count"`
+	LastReplyAt *time.Time   `bson:"last_reply_at,omitempty" json:"last_reply_at,omitempty"`
+	Attachments []Attachment `bson:"attachments,omitempty"   json:"attachments,omitempty"`
+	// Moderation is set when NG filters matched without blocking the send.
+	Moderation *MessageModeration `bson:"moderation,omitempty" json:"moderation,omitempty"`
+}
+
+type MessageModeration struct {
+	Action    NGFilterAction `bson:"action"     json:"action"`
+	FilterIDs []string       `bson:"filter_ids" json:"filter_ids"`
+}
+
+// IsShadowed reports whether m is visible to its sender only, in which case
+// nothing about it may be published to the group.
+func (m *Message) IsShadowed() bool {
+	return m.Moderation != nil && m.Moderation.Action == NGFilterActionShadow
+}
+
+func (d MsgSortDirection) IsValid() bool {
+	return d == MsgSortAsc || d == MsgSortDesc
+}
+
+func (d MsgSortDirection) Reverse() MsgSortDirection {
+	if d == MsgSortAsc {
+		return MsgSortDesc
+	}
+	return MsgSortAsc
 }
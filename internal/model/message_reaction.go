@@ -0,0 +1,12 @@
+package model
+
+const MessageReactionCollectionName = "message_reactions"
+
+// MessageReaction is one member reacting to one message with one emoji.
+type MessageReaction struct {
+	BaseModel `bson:",inline"   json:",inline"`
+	MessageID string `bson:"message_id" json:"message_id"`
+	GroupID   string `bson:"group_id"   json:"group_id"`
+	MemberID  string `bson:"member_id"  json:"member_id"`
+	Emoji     string `bson:"emoji"      json:"emoji"`
+}
@@ -0,0 +1,14 @@
+package model
+
+const MessageRevisionCollectionName = "message_revisions"
+
+// MessageRevision is a message as it was before an edit. CreatedAt is the
+// time of the edit which replaced it.
+type MessageRevision struct {
+	BaseModel  `bson:",inline"            json:",inline"`
+	MessageID  string             `bson:"message_id"           json:"message_id"`
+	GroupID    string             `bson:"group_id"             json:"group_id"`
+	EditorID   string             `bson:"editor_id"            json:"editor_id"`
+	Message    string             `bson:"message"              json:"message"`
+	Moderation *MessageModeration `bson:"moderation,omitempty" json:"moderation,omitempty"`
+}
@@ -0,0 +1,15 @@
+package model
+
+const NGFilterHitCollectionName = "ng_filter_hits"
+
+// NGFilterHit records one filter matching one message. Action is what was
+// done to the message, the strongest action of all its matches, so it may
+// differ from the filter's own action.
+type NGFilterHit struct {
+	BaseModel `bson:",inline"            json:",inline"`
+	FilterID  string         `bson:"filter_id"            json:"filter_id"`
+	GroupID   string         `bson:"group_id"             json:"group_id"`
+	SenderID  string         `bson:"sender_id"            json:"sender_id"`
+	MessageID string         `bson:"message_id,omitempty" json:"message_id,omitempty"`
+	Action    NGFilterAction `bson:"action"               json:"action"`
+}
@@ -0,0 +1,108 @@
+package ngfilter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/noxhalley/funken/config"
+	"golang.org/x/sync/singleflight"
+)
+
+// cacheLoadTimeout bounds a load, which outlives the caller that started
+// it since every concurrent caller waits for its result.
+const cacheLoadTimeout = 10 * time.Second
+
+type cacheEntry struct {
+	set      *Set
+	loadedAt time.Time
+}
+
+// Cache keeps compiled filter sets per group. Entries are dropped by
+// Invalidate, and expire after the TTL as a safety net for invalidations
+// missed while NATS was disconnected.
+type Cache struct {
+	ttl   time.Duration
+	group singleflight.Group
+
+	mu      sync.RWMutex
+	entries map[string]cacheEntry
+	// loads holds the groups being loaded. An invalidation racing with a
+	// load marks it stale, so that its result is not cached.
+	loads map[string]bool
+}
+
+func NewCache(cfg *config.Config) *Cache {
+	return &Cache{
+		ttl:     time.Duration(cfg.NGFilter.CacheTTL) * time.Millisecond,
+		entries: make(map[string]cacheEntry),
+		loads:   make(map[string]bool),
+	}
+}
+
+func (c *Cache) Get(
+	ctx context.Context,
+	groupID string,
+	load func(ctx context.Context, groupID string) (*Set, error),
+) (*Set, error) {
+	c.mu.RLock()
+	entry, ok := c.entries[groupID]
+	c.mu.RUnlock()
+	if ok && time.Since(entry.loadedAt) < c.ttl {
+		return entry.set, nil
+	}
+
+	ch := c.group.DoChan(groupID, func() (interface{}, error) {
+		c.mu.Lock()
+		c.loads[groupID] = false
+		c.mu.Unlock()
+
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cacheLoadTimeout)
+		defer cancel()
+		set, err := load(loadCtx, groupID)
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		stale := c.loads[groupID]
+		delete(c.loads, groupID)
+		if err != nil {
+			return nil, err
+		}
+		if !stale {
+			c.entries[groupID] = cacheEntry{set: set, loadedAt: time.Now()}
+		}
+		return set, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*Set), nil
+	}
+}
+
+func (c *Cache) Invalidate(groupIDs ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, id := range groupIDs {
+		delete(c.entries, id)
+		if _, ok := c.loads[id]; ok {
+			c.loads[id] = true
+		}
+	}
+}
+
+func (c *Cache) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.entries)
+	for id := range c.loads {
+		c.loads[id] = true
+	}
+}
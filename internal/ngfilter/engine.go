@@ -0,0 +1,151 @@
+package ngfilter
+
+import (
+	"context"
+	"slices"
+
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type Result struct {
+	Matches []Match
+}
+
+func (r *Result) Matched() bool {
+	return len(r.Matches) > 0
+}
+
+// Action returns the strongest action among the matches, empty when
+// nothing matched.
+func (r *Result) Action() model.NGFilterAction {
+	var action model.NGFilterAction
+	for _, m := range r.Matches {
+		if m.Action.Stronger(action) {
+			action = m.Action
+		}
+	}
+	return action
+}
+
+// MatchesWith returns the matches whose filter takes the given action.
+func (r *Result) MatchesWith(action model.NGFilterAction) []Match {
+	var matches []Match
+	for _, m := range r.Matches {
+		if m.Action == action {
+			matches = append(matches, m)
+		}
+	}
+	return matches
+}
+
+// Engine evaluates message text against the NG filters of a group.
+//
+// The filters of a group are its own plus the global ones, those stored
+// without a group, except for the globals the group opted out of.
+type Engine interface {
+	Evaluate(ctx context.Context, groupID string, text string) (*Result, error)
+
+	EffectiveFilters(ctx context.Context, groupID string) ([]model.GroupNGFilter, error)
+
+	SetGlobalOptOut(ctx context.Context, groupID string, filterID string, optOut bool) error
+}
+
+type engine struct {
+	logger       *log.Logger
+	ngFilterRepo repository.GroupNGFilterRepository
+	groupRepo    repository.GroupRepository
+	cache        *Cache
+	invalidator  *Invalidator
+	normalizer   *Normalizer
+}
+
+func NewEngine(
+	ngFilterRepo repository.GroupNGFilterRepository,
+	groupRepo repository.GroupRepository,
+	cache *Cache,
+	invalidator *Invalidator,
+	normalizer *Normalizer,
+) Engine {
+	return &engine{
+		logger:       log.With("service", "ng_filter_engine"),
+		ngFilterRepo: ngFilterRepo,
+		groupRepo:    groupRepo,
+		cache:        cache,
+		invalidator:  invalidator,
+		normalizer:   normalizer,
+	}
+}
+
+// Evaluate implements Engine.
+func (e *engine) Evaluate(ctx context.Context, groupID string, text string) (*Result, error) {
+	set, err := e.cache.Get(ctx, groupID, e.load)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{Matches: set.Evaluate(text)}, nil
+}
+
+// EffectiveFilters implements Engine. Global filters come first.
+func (e *engine) EffectiveFilters(ctx context.Context, groupID string) ([]model.GroupNGFilter, error) {
+	group, err := e.groupRepo.FindOneByConditions(ctx, bson.M{"id": groupID}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	globals, err := e.ngFilterRepo.FindGlobal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	own, err := e.ngFilterRepo.FindByConditions(ctx, bson.M{"group_id": groupID}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make([]model.GroupNGFilter, 0, len(globals)+len(own))
+	for _, f := range globals {
+		if !slices.Contains(group.NGFilterOptOuts, f.ID) {
+			filters = append(filters, f)
+		}
+	}
+	return append(filters, own...), nil
+}
+
+// SetGlobalOptOut implements Engine.
+func (e *engine) SetGlobalOptOut(ctx context.Context, groupID string, filterID string, optOut bool) error {
+	ngFilter, err := e.ngFilterRepo.FindOneByConditions(ctx, bson.M{"id": filterID}, nil)
+	if err != nil {
+		return err
+	}
+	if !ngFilter.IsGlobal() {
+		return invalid(ErrNotGlobal)
+	}
+
+	op := "$pull"
+	if optOut {
+		op = "$addToSet"
+	}
+	update := bson.M{op: bson.M{"ng_filter_opt_outs": filterID}}
+	if _, err := e.groupRepo.UpdateByID(ctx, groupID, update); err != nil {
+		return err
+	}
+
+	e.invalidator.Invalidate(ctx, groupID)
+	return nil
+}
+
+func (e *engine) load(ctx context.Context, groupID string) (*Set, error) {
+	filters, err := e.EffectiveFilters(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	set, err := Compile(filters, e.normalizer)
+	if err != nil {
+		// broken filters are skipped, the valid ones still apply
+		e.logger.Warn(ctx, "some NG filters failed to compile", "group_id", groupID, "error", err)
+	}
+	return set, nil
+}
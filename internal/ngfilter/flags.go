@@ -0,0 +1,64 @@
+package ngfilter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Flags understood in model.GroupNGFilter.Flags, written as a string of
+// letters such as "iw".
+const (
+	FlagCaseInsensitive = 'i'
+	FlagMultiline       = 'm'
+	FlagDotAll          = 's'
+	FlagWholeWord       = 'w'
+	FlagNormalize       = 'n'
+)
+
+type Flags struct {
+	CaseInsensitive bool
+	Multiline       bool
+	DotAll          bool
+	WholeWord       bool
+	Normalize       bool
+}
+
+func ParseFlags(raw string) (Flags, error) {
+	flags := Flags{}
+	for _, r := range raw {
+		switch r {
+		case FlagCaseInsensitive:
+			flags.CaseInsensitive = true
+		case FlagMultiline:
+			flags.Multiline = true
+		case FlagDotAll:
+			flags.DotAll = true
+		case FlagWholeWord:
+			flags.WholeWord = true
+		case FlagNormalize:
+			flags.Normalize = true
+		default:
+			return Flags{}, fmt.Errorf("%w: %q", ErrInvalidFlag, r)
+		}
+	}
+	return flags, nil
+}
+
+// regexpPrefix returns the inline flag group for the flags Go's regexp
+// supports natively. Whole-word matching is handled by the matcher.
+func (f Flags) regexpPrefix() string {
+	var sb strings.Builder
+	if f.CaseInsensitive {
+		sb.WriteByte('i')
+	}
+	if f.Multiline {
+		sb.WriteByte('m')
+	}
+	if f.DotAll {
+		sb.WriteByte('s')
+	}
+	if sb.Len() == 0 {
+		return ""
+	}
+	return "(?" + sb.String() + ")"
+}
@@ -0,0 +1,95 @@
+package ngfilter
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/noxhalley/funken/internal/event"
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/infrastructure/pubsub"
+)
+
+const listenRetryDelay = 2 * time.Second
+
+type invalidation struct {
+	GroupIDs []string `json:"group_ids,omitempty"`
+	All      bool     `json:"all,omitempty"`
+}
+
+// Invalidator drops cached filter sets locally and tells every other
+// replica to do the same.
+type Invalidator struct {
+	logger      *log.Logger
+	cache       *Cache
+	broadcaster pubsub.Broadcaster
+}
+
+func NewInvalidator(cache *Cache, broadcaster pubsub.Broadcaster) *Invalidator {
+	return &Invalidator{
+		logger:      log.With("service", "ng_filter_invalidator"),
+		cache:       cache,
+		broadcaster: broadcaster,
+	}
+}
+
+func (i *Invalidator) Invalidate(ctx context.Context, groupIDs ...string) {
+	if len(groupIDs) == 0 {
+		return
+	}
+	// global filters are part of every group's set
+	if slices.Contains(groupIDs, "") {
+		i.InvalidateAll(ctx)
+		return
+	}
+	i.cache.Invalidate(groupIDs...)
+	i.broadcast(ctx, invalidation{GroupIDs: groupIDs})
+}
+
+func (i *Invalidator) InvalidateAll(ctx context.Context) {
+	i.cache.InvalidateAll()
+	i.broadcast(ctx, invalidation{All: true})
+}
+
+func (i *Invalidator) broadcast(ctx context.Context, inv invalidation) {
+	// the cache TTL bounds staleness on other replicas if this fails
+	if err := i.broadcaster.Broadcast(ctx, event.NGFilterInvalidationSubject, inv); err != nil {
+		i.logger.Warn(ctx, "failed to broadcast NG filter invalidation", "error", err)
+	}
+}
+
+// Listen applies invalidations broadcast by other replicas until ctx is
+// done. A failed subscription is retried, and the whole cache dropped each
+// time since invalidations may have been missed in the meantime.
+func (i *Invalidator) Listen(ctx context.Context) {
+	handle := func(data []byte) {
+		inv := invalidation{}
+		if err := json.Unmarshal(data, &inv); err != nil {
+			i.logger.Warn(ctx, "dropping malformed NG filter invalidation", "error", err)
+			i.cache.InvalidateAll()
+			return
+		}
+
+		if inv.All {
+			i.cache.InvalidateAll()
+			return
+		}
+		i.cache.Invalidate(inv.GroupIDs...)
+	}
+
+	for {
+		err := i.broadcaster.Listen(ctx, event.NGFilterInvalidationSubject, handle)
+		if ctx.Err() != nil {
+			return
+		}
+
+		i.logger.Warn(ctx, "NG filter invalidations interrupted, retrying", "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryDelay):
+		}
+		i.cache.InvalidateAll()
+	}
+}
@@ -0,0 +1,177 @@
+package ngfilter
+
+import (
+	"regexp/syntax"
+	"unicode"
+	"unicode/utf8"
+)
+
+// literalPattern reports whether pattern, under the given flags, matches
+// a fixed string only, and returns that string as runes. Escaped
+// metacharacters such as `\.` still count as literals.
+func literalPattern(pattern string, flags Flags) (runes []rune, fold bool, ok bool) {
+	parseFlags := syntax.Perl
+	if flags.CaseInsensitive {
+		parseFlags |= syntax.FoldCase
+	}
+
+	re, err := syntax.Parse(pattern, parseFlags)
+	if err != nil || re.Op != syntax.OpLiteral || len(re.Rune) == 0 {
+		return nil, false, false
+	}
+	return re.Rune, re.Flags&syntax.FoldCase != 0, true
+}
+
+// foldRune maps every rune of a case folding orbit to the same rune, the
+// smallest of the orbit, e.g. 'k', 'K' and the Kelvin sign all to 'K'.
+func foldRune(r rune) rune {
+	if r < utf8.RuneSelf {
+		if 'a' <= r && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+		return r
+	}
+
+	min := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < min {
+			min = f
+		}
+	}
+	return min
+}
+
+type acNode struct {
+	next  map[rune]int32
+	fail  int32
+	depth int32
+	// out holds the literals ending at this node, dict the nearest node on
+	// the fail chain which has any.
+	out  []int32
+	dict int32
+}
+
+// acMatcher is an Aho-Corasick automaton over runes which finds every
+// occurrence of every literal in a single pass over the text.
+type acMatcher struct {
+	nodes []acNode
+	fold  bool
+}
+
+type acHit struct {
+	literal int32
+	span    Span
+}
+
+func newACMatcher(literals [][]rune, fold bool) *acMatcher {
+	m := &acMatcher{
+		nodes: []acNode{{next: map[rune]int32{}, dict: -1}},
+		fold:  fold,
+	}
+
+	for i, lit := range literals {
+		cur := int32(0)
+		for _, r := range lit {
+			if fold {
+				r = foldRune(r)
+			}
+
+			nxt, ok := m.nodes[cur].next[r]
+			if !ok {
+				nxt = int32(len(m.nodes))
+				m.nodes = append(m.nodes, acNode{
+					next:  map[rune]int32{},
+					depth: m.nodes[cur].depth + 1,
+					dict:  -1,
+				})
+				m.nodes[cur].next[r] = nxt
+			}
+			cur = nxt
+		}
+		m.nodes[cur].out = append(m.nodes[cur].out, int32(i))
+	}
+
+	m.link()
+	return m
+}
+
+// link computes fail and dictionary links breadth first.
+func (m *acMatcher) link() {
+	queue := make([]int32, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		for r, child := range m.nodes[cur].next {
+			fail := m.nodes[cur].fail
+			for {
+				if nxt, ok := m.nodes[fail].next[r]; ok && nxt != child {
+					m.nodes[child].fail = nxt
+					break
+				}
+				if fail == 0 {
+					m.nodes[child].fail = 0
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+
+			f := m.nodes[child].fail
+			if len(m.nodes[f].out) > 0 {
+				m.nodes[child].dict = f
+			} else {
+				m.nodes[child].dict = m.nodes[f].dict
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+// find reports every occurrence, overlapping ones included, as byte spans
+// of text.
+func (m *acMatcher) find(text string) []acHit {
+	var hits []acHit
+
+	// byte offset of each rune seen so far, to turn a match length in
+	// runes back into a start offset
+	offsets := make([]int, 0, len(text))
+	cur := int32(0)
+
+	for pos := 0; pos < len(text); {
+		r, size := utf8.DecodeRuneInString(text[pos:])
+		offsets = append(offsets, pos)
+		end := pos + size
+		pos = end
+
+		if m.fold {
+			r = foldRune(r)
+		}
+
+		for {
+			if nxt, ok := m.nodes[cur].next[r]; ok {
+				cur = nxt
+				break
+			}
+			if cur == 0 {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+
+		for n := cur; n >= 0; n = m.nodes[n].dict {
+			node := &m.nodes[n]
+			for _, lit := range node.out {
+				start := offsets[len(offsets)-int(node.depth)]
+				hits = append(hits, acHit{literal: lit, span: Span{Start: start, End: end}})
+			}
+			if n == 0 {
+				break
+			}
+		}
+	}
+	return hits
+}
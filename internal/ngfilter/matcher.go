@@ -0,0 +1,316 @@
+package ngfilter
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/noxhalley/funken/internal/model"
+)
+
+var (
+	ErrInvalidFlag    = errors.New("invalid NG filter flag")
+	ErrInvalidPattern = errors.New("invalid NG filter pattern")
+)
+
+// Span is a byte range [Start, End) of the evaluated text.
+type Span struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+type Match struct {
+	FilterID string               `json:"filter_id"`
+	Title    string               `json:"title"`
+	Action   model.NGFilterAction `json:"action"`
+	Spans    []Span               `json:"spans"`
+}
+
+type rule struct {
+	filter    model.GroupNGFilter
+	re        *regexp.Regexp
+	wholeWord bool
+}
+
+// literal is a filter whose pattern is a fixed string, matched through the
+// automaton of its Set instead of a regexp.
+type literal struct {
+	filter    int
+	runes     []rune
+	wholeWord bool
+}
+
+// Set is an immutable compiled set of filters, safe for concurrent use.
+//
+// Literal patterns, usually the bulk of a banned word list, are matched
+// together in a single pass by an Aho-Corasick automaton, one for case
+// sensitive and one for case-folded literals. Only real patterns pay for a
+// regexp each.
+type Set struct {
+	filters []model.GroupNGFilter
+	rules   []rule
+	// rules[i] belongs to filters[ruleFilter[i]]
+	ruleFilter []int
+
+	literals      []literal
+	exact, folded *acMatcher
+	// index into literals of each automaton's literals
+	exactLits, foldedLits []int
+
+	// normalized holds the filters flagged for normalization, matched once
+	// more against the text rewritten by normalizer. Its filters[i] is
+	// filters[normalizedFilter[i]] of this set.
+	normalized       *Set
+	normalizer       *Normalizer
+	normalizedFilter []int
+}
+
+// Compile builds a Set from filters. Filters that fail to compile are left
+// out of the set and reported together in the returned error, so a single
+// bad pattern does not disable the rest. normalizer may be nil, which
+// leaves out the leet map only.
+func Compile(filters []model.GroupNGFilter, normalizer *Normalizer) (*Set, error) {
+	return compile(filters, true, normalizer)
+}
+
+func compile(filters []model.GroupNGFilter, detectLiterals bool, normalizer *Normalizer) (*Set, error) {
+	set, err := compileSet(filters, detectLiterals)
+
+	var normalized []model.GroupNGFilter
+	for i, f := range set.filters {
+		// flags already parsed fine for every filter left in the set
+		if flags, _ := ParseFlags(f.Flags); flags.Normalize {
+			normalized = append(normalized, f)
+			set.normalizedFilter = append(set.normalizedFilter, i)
+		}
+	}
+	if len(normalized) > 0 {
+		set.normalized, _ = compileSet(normalized, detectLiterals)
+		set.normalizer = normalizer
+	}
+	return set, err
+}
+
+func compileSet(filters []model.GroupNGFilter, detectLiterals bool) (*Set, error) {
+	set := &Set{filters: make([]model.GroupNGFilter, 0, len(filters))}
+
+	var (
+		errs                    []error
+		exactRunes, foldedRunes [][]rune
+	)
+	for _, f := range filters {
+		flags, err := ParseFlags(f.Flags)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("filter %s: %w", f.ID, err))
+			continue
+		}
+
+		if detectLiterals {
+			if runes, fold, ok := literalPattern(f.Pattern, flags); ok {
+				set.literals = append(set.literals, literal{
+					filter:    len(set.filters),
+					runes:     runes,
+					wholeWord: flags.WholeWord,
+				})
+				if fold {
+					set.foldedLits = append(set.foldedLits, len(set.literals)-1)
+					foldedRunes = append(foldedRunes, runes)
+				} else {
+					set.exactLits = append(set.exactLits, len(set.literals)-1)
+					exactRunes = append(exactRunes, runes)
+				}
+				set.filters = append(set.filters, f)
+				continue
+			}
+		}
+
+		r, err := compileRule(f, flags)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("filter %s: %w", f.ID, err))
+			continue
+		}
+		set.rules = append(set.rules, r)
+		set.ruleFilter = append(set.ruleFilter, len(set.filters))
+		set.filters = append(set.filters, f)
+	}
+
+	if len(exactRunes) > 0 {
+		set.exact = newACMatcher(exactRunes, false)
+	}
+	if len(foldedRunes) > 0 {
+		set.folded = newACMatcher(foldedRunes, true)
+	}
+	return set, errors.Join(errs...)
+}
+
+func compileRule(f model.GroupNGFilter, flags Flags) (rule, error) {
+	re, err := regexp.Compile(flags.regexpPrefix() + f.Pattern)
+	if err != nil {
+		return rule{}, fmt.Errorf("%w: %v", ErrInvalidPattern, err)
+	}
+
+	return rule{
+		filter:    f,
+		re:        re,
+		wholeWord: flags.WholeWord,
+	}, nil
+}
+
+func (s *Set) Len() int {
+	return len(s.filters)
+}
+
+// Evaluate returns the filters matching text, in filter order.
+func (s *Set) Evaluate(text string) []Match {
+	spans := s.spans(text)
+
+	if s.normalized != nil {
+		norm := s.normalizer.Normalize(text)
+		for i, found := range s.normalized.spans(norm.Text) {
+			filter := s.normalizedFilter[i]
+			for _, span := range found {
+				spans[filter] = append(spans[filter], norm.Original(span))
+			}
+			spans[filter] = dedupSpans(spans[filter])
+		}
+	}
+
+	if len(spans) == 0 {
+		return nil
+	}
+
+	matches := make([]Match, 0, len(spans))
+	for i, f := range s.filters {
+		if found, ok := spans[i]; ok {
+			matches = append(matches, Match{
+				FilterID: f.ID,
+				Title:    f.Title,
+				Action:   f.EffectiveAction(),
+				Spans:    found,
+			})
+		}
+	}
+	return matches
+}
+
+// spans returns the spans found in text by index of their filter.
+func (s *Set) spans(text string) map[int][]Span {
+	spans := make(map[int][]Span)
+	add := func(filter int, found []Span) {
+		if len(found) > 0 {
+			spans[filter] = append(spans[filter], found...)
+		}
+	}
+
+	for i, r := range s.rules {
+		add(s.ruleFilter[i], r.find(text))
+	}
+	s.findLiterals(text, s.exact, s.exactLits, add)
+	s.findLiterals(text, s.folded, s.foldedLits, add)
+	return spans
+}
+
+// dedupSpans sorts spans and drops the ones found in both the original and
+// the normalized text.
+func dedupSpans(spans []Span) []Span {
+	slices.SortFunc(spans, func(a, b Span) int {
+		if a.Start != b.Start {
+			return a.Start - b.Start
+		}
+		return a.End - b.End
+	})
+	return slices.Compact(spans)
+}
+
+// findLiterals runs one automaton over text. Like the regexp path, it keeps
+// only the leftmost non-overlapping occurrences of each literal.
+func (s *Set) findLiterals(text string, m *acMatcher, lits []int, add func(int, []Span)) {
+	if m == nil {
+		return
+	}
+
+	hits := m.find(text)
+	if len(hits) == 0 {
+		return
+	}
+
+	// hits come in order of their end offset, which for a single literal is
+	// also the order of their start
+	found := make(map[int32][]Span)
+	for _, h := range hits {
+		lit := s.literals[lits[h.literal]]
+		if lit.wholeWord && !isWordBoundary(text, h.span.Start, h.span.End) {
+			continue
+		}
+		prev := found[h.literal]
+		if n := len(prev); n > 0 && h.span.Start < prev[n-1].End {
+			continue
+		}
+		found[h.literal] = append(prev, h.span)
+	}
+
+	for i, spans := range found {
+		add(s.literals[lits[i]].filter, spans)
+	}
+}
+
+func (r rule) find(text string) []Span {
+	locs := r.re.FindAllStringIndex(text, -1)
+	spans := make([]Span, 0, len(locs))
+	for _, loc := range locs {
+		if loc[1] == loc[0] {
+			continue
+		}
+		// regexp's \b only knows ASCII words, so boundaries are checked
+		// here against Unicode letters and digits instead, on the whole
+		// text so that ^ and $ keep their meaning.
+		if r.wholeWord && !isWordBoundary(text, loc[0], loc[1]) {
+			continue
+		}
+		spans = append(spans, Span{Start: loc[0], End: loc[1]})
+	}
+	return spans
+}
+
+func isWordBoundary(text string, start, end int) bool {
+	if before, _ := utf8.DecodeLastRuneInString(text[:start]); start > 0 && isWordRune(before) {
+		return false
+	}
+	if after, _ := utf8.DecodeRuneInString(text[end:]); end < len(text) && isWordRune(after) {
+		return false
+	}
+	return true
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Mask replaces every matched rune with an asterisk.
+func Mask(text string, matches []Match) string {
+	if len(matches) == 0 {
+		return text
+	}
+
+	masked := make([]bool, len(text))
+	for _, m := range matches {
+		for _, span := range m.Spans {
+			for i := span.Start; i < span.End; i++ {
+				masked[i] = true
+			}
+		}
+	}
+
+	out := make([]rune, 0, utf8.RuneCountInString(text))
+	for i, r := range text {
+		if masked[i] {
+			out = append(out, '*')
+			continue
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
@@ -0,0 +1,83 @@
+package ngfilter
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/noxhalley/funken/internal/model"
+)
+
+const benchMessage = "Hey everyone, the release notes for this week are up on the wiki. " +
+	"Please take a look before the meeting tomorrow and leave comments inline. " +
+	"今日の会議は十五時からです。資料は共有フォルダにあります。"
+
+func benchFilters(n int) []model.GroupNGFilter {
+	rng := rand.New(rand.NewSource(int64(n)))
+	const letters = "abcdefghijklmnopqrstuvwxyz"
+
+	filters := make([]model.GroupNGFilter, n)
+	for i := range filters {
+		var sb strings.Builder
+		for j := 0; j < 5+rng.Intn(6); j++ {
+			sb.WriteByte(letters[rng.Intn(len(letters))])
+		}
+
+		f := model.GroupNGFilter{Title: sb.String(), Pattern: sb.String()}
+		f.ID = strconv.Itoa(i)
+		switch i % 3 {
+		case 1:
+			f.Flags = "i"
+		case 2:
+			f.Flags = "iw"
+		}
+		filters[i] = f
+	}
+	return filters
+}
+
+// BenchmarkEvaluate compares throughput of the literal automaton against
+// one regexp per filter as the list grows.
+func BenchmarkEvaluate(b *testing.B) {
+	for _, n := range []int{10, 100, 1000, 10000} {
+		filters := benchFilters(n)
+
+		for _, mode := range []struct {
+			name     string
+			literals bool
+		}{
+			{"literal", true},
+			{"regexp", false},
+		} {
+			set, err := compile(filters, mode.literals, nil)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			b.Run(fmt.Sprintf("%s/filters=%d", mode.name, n), func(b *testing.B) {
+				b.SetBytes(int64(len(benchMessage)))
+				b.ReportAllocs()
+				for b.Loop() {
+					set.Evaluate(benchMessage)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkCompile(b *testing.B) {
+	for _, n := range []int{100, 1000, 10000} {
+		filters := benchFilters(n)
+
+		b.Run(fmt.Sprintf("filters=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := Compile(filters, nil); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package ngfilter
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/noxhalley/funken/internal/model"
+)
+
+func TestRuleFind(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		flags   string
+		text    string
+		want    []Span
+	}{
+		{"plain", "cat", "", "cat concat", []Span{{0, 3}, {7, 10}}},
+		{"whole word", "cat", "w", "cat concat cat", []Span{{0, 3}, {11, 14}}},
+		{"whole word unicode", "猫", "w", "子猫 猫", []Span{{7, 10}}},
+		{"anchored", "^cat", "w", "dog cat", nil},
+		{"anchored start", "^cat", "w", "cat dog", []Span{{0, 3}}},
+		{"empty matches", "x*", "", "ab", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := model.GroupNGFilter{Pattern: tt.pattern, Flags: tt.flags}
+			flags, err := ParseFlags(f.Flags)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r, err := compileRule(f, flags)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := r.find(tt.text)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("find(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package ngfilter
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/noxhalley/funken/config"
+	"golang.org/x/text/unicode/norm"
+)
+
+// confusables folds common homoglyphs from other scripts onto the Latin
+// letters they imitate. Full-width and mathematical letters need no entry,
+// NFKC already folds them.
+var confusables = map[rune]rune{
+	// Cyrillic
+	'а': 'a', 'в': 'b', 'е': 'e', 'к': 'k', 'м': 'm', 'н': 'h', 'о': 'o',
+	'р': 'p', 'с': 'c', 'т': 't', 'у': 'y', 'х': 'x', 'ѕ': 's', 'і': 'i',
+	'ј': 'j', 'ԁ': 'd', 'ɡ': 'g', 'ӏ': 'l',
+	'А': 'A', 'В': 'B', 'Е': 'E', 'К': 'K', 'М': 'M', 'Н': 'H', 'О': 'O',
+	'Р': 'P', 'С': 'C', 'Т': 'T', 'У': 'Y', 'Х': 'X', 'Ѕ': 'S', 'І': 'I',
+	'Ј': 'J',
+	// Greek
+	'α': 'a', 'β': 'b', 'ε': 'e', 'ι': 'i', 'κ': 'k', 'ν': 'v', 'ο': 'o',
+	'ρ': 'p', 'τ': 't', 'υ': 'u', 'χ': 'x',
+	'Α': 'A', 'Β': 'B', 'Ε': 'E', 'Ζ': 'Z', 'Η': 'H', 'Ι': 'I', 'Κ': 'K',
+	'Μ': 'M', 'Ν': 'N', 'Ο': 'O', 'Ρ': 'P', 'Τ': 'T', 'Υ': 'Y', 'Χ': 'X',
+}
+
+// Normalizer rewrites message text into a canonical form which defeats the
+// usual filter evasions: NFKC folds full-width and stylised letters,
+// invisible characters are dropped, homoglyphs and leetspeak are folded
+// onto plain letters. Filters with the FlagNormalize flag are matched
+// against both the original and the normalized text.
+type Normalizer struct {
+	leet map[rune]rune
+}
+
+// NewNormalizer reads the leet map from entries such as "4=a".
+func NewNormalizer(cfg *config.Config) (*Normalizer, error) {
+	leet := make(map[rune]rune, len(cfg.NGFilter.LeetMap))
+	for _, entry := range cfg.NGFilter.LeetMap {
+		from, to, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || utf8.RuneCountInString(from) != 1 || utf8.RuneCountInString(to) != 1 {
+			return nil, fmt.Errorf("invalid leet map entry %q", entry)
+		}
+
+		r, _ := utf8.DecodeRuneInString(from)
+		leet[r], _ = utf8.DecodeRuneInString(to)
+	}
+	return &Normalizer{leet: leet}, nil
+}
+
+// Normalized is a normalized text along with, for each of its bytes, the
+// byte range of the original text it came from.
+type Normalized struct {
+	Text      string
+	origStart []int
+	origEnd   []int
+}
+
+// Normalize rewrites text. It is safe to call on a nil Normalizer, which
+// applies everything but the leet map.
+func (n *Normalizer) Normalize(text string) *Normalized {
+	out := &Normalized{
+		origStart: make([]int, 0, len(text)),
+		origEnd:   make([]int, 0, len(text)),
+	}
+
+	var (
+		sb   strings.Builder
+		iter norm.Iter
+	)
+	sb.Grow(len(text))
+	iter.InitString(norm.NFKC, text)
+
+	// NFKC works on segments, a base rune and what combines with it, so
+	// every rune written for a segment maps back to the whole segment.
+	for !iter.Done() {
+		start := iter.Pos()
+		segment := iter.Next()
+		end := iter.Pos()
+
+		for len(segment) > 0 {
+			r, size := utf8.DecodeRune(segment)
+			segment = segment[size:]
+
+			if isInvisible(r) {
+				continue
+			}
+			r = n.fold(r)
+
+			before := sb.Len()
+			sb.WriteRune(r)
+			for range sb.Len() - before {
+				out.origStart = append(out.origStart, start)
+				out.origEnd = append(out.origEnd, end)
+			}
+		}
+	}
+
+	out.Text = sb.String()
+	return out
+}
+
+func (n *Normalizer) fold(r rune) rune {
+	if c, ok := confusables[r]; ok {
+		return c
+	}
+	if n != nil {
+		if l, ok := n.leet[r]; ok {
+			return l
+		}
+	}
+	return r
+}
+
+// isInvisible reports whether r renders as nothing, like zero-width
+// spaces and joiners, bidi controls or variation selectors.
+func isInvisible(r rune) bool {
+	return unicode.Is(unicode.Cf, r) ||
+		unicode.Is(unicode.Variation_Selector, r) ||
+		unicode.Is(unicode.Other_Default_Ignorable_Code_Point, r)
+}
+
+// Original maps a non-empty span of the normalized text back to the
+// original.
+func (n *Normalized) Original(span Span) Span {
+	return Span{
+		Start: n.origStart[span.Start],
+		End:   n.origEnd[span.End-1],
+	}
+}
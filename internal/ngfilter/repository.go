@@ -0,0 +1,245 @@
+package ngfilter
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type invalidatingRepo struct {
+	repository.GroupNGFilterRepository
+	invalidator *Invalidator
+}
+
+// NewInvalidatingRepository decorates repo so that every successful write
+// invalidates the cached filter sets of the groups it touched.
+func NewInvalidatingRepository(
+	repo repository.GroupNGFilterRepository,
+	invalidator *Invalidator,
+) repository.GroupNGFilterRepository {
+	return &invalidatingRepo{
+		GroupNGFilterRepository: repo,
+		invalidator:             invalidator,
+	}
+}
+
+// Create implements repository.GroupNGFilterRepository.
+func (r *invalidatingRepo) Create(ctx context.Context, ngFilter model.GroupNGFilter) error {
+	if err := r.GroupNGFilterRepository.Create(ctx, ngFilter); err != nil {
+		return err
+	}
+	r.invalidator.Invalidate(ctx, ngFilter.GroupID)
+	return nil
+}
+
+// CreateBatch implements repository.GroupNGFilterRepository.
+func (r *invalidatingRepo) CreateBatch(ctx context.Context, ngFilters []model.GroupNGFilter) error {
+	err := r.GroupNGFilterRepository.CreateBatch(ctx, ngFilters)
+	// an ordered insert can fail halfway, so invalidate regardless
+	groupIDs := make([]string, 0, len(ngFilters))
+	seen := make(map[string]struct{}, len(ngFilters))
+	for _, f := range ngFilters {
+		if _, ok := seen[f.GroupID]; !ok {
+			seen[f.GroupID] = struct{}{}
+			groupIDs = append(groupIDs, f.GroupID)
+		}
+	}
+	r.invalidator.Invalidate(ctx, groupIDs...)
+	return err
+}
+
+// UpdateByID implements repository.GroupNGFilterRepository. The group of
+// the filter is read first, since an update may move it to another group.
+func (r *invalidatingRepo) UpdateByID(
+	ctx context.Context,
+	ID string,
+	operation interface{},
+) (*model.GroupNGFilter, error) {
+	before, err := r.GroupNGFilterRepository.FindOneByConditions(ctx, bson.M{"id": ID}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := r.GroupNGFilterRepository.UpdateByID(ctx, ID, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs := []string{before.GroupID}
+	if updated.GroupID != before.GroupID {
+		groupIDs = append(groupIDs, updated.GroupID)
+	}
+	r.invalidator.Invalidate(ctx, groupIDs...)
+	return updated, nil
+}
+
+// DeleteByID implements repository.GroupNGFilterRepository.
+func (r *invalidatingRepo) DeleteByID(ctx context.Context, ID string) error {
+	before, err := r.GroupNGFilterRepository.FindOneByConditions(ctx, bson.M{"id": ID}, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := r.GroupNGFilterRepository.DeleteByID(ctx, ID); err != nil {
+		return err
+	}
+	r.invalidator.Invalidate(ctx, before.GroupID)
+	return nil
+}
+
+// DeleteByGroupIDs implements repository.GroupNGFilterRepository.
+func (r *invalidatingRepo) DeleteByGroupIDs(ctx context.Context, groupIDs []string) error {
+	if err := r.GroupNGFilterRepository.DeleteByGroupIDs(ctx, groupIDs); err != nil {
+		return err
+	}
+	r.invalidator.Invalidate(ctx, groupIDs...)
+	return nil
+}
+
+// WriteBatch implements repository.GroupNGFilterRepository. Like
+// CreateBatch, it invalidates regardless of errors, and does so once for
+// the whole batch.
+func (r *invalidatingRepo) WriteBatch(ctx context.Context, batch repository.NGFilterBatch) error {
+	err := r.GroupNGFilterRepository.WriteBatch(ctx, batch)
+	groupIDs := []string{}
+	for _, filters := range [][]model.GroupNGFilter{batch.Create, batch.Update, batch.Delete} {
+		for _, f := range filters {
+			if !slices.Contains(groupIDs, f.GroupID) {
+				groupIDs = append(groupIDs, f.GroupID)
+			}
+		}
+	}
+	r.invalidator.Invalidate(ctx, groupIDs...)
+	return err
+}
+
+type validatingRepo struct {
+	repository.GroupNGFilterRepository
+	validator *Validator
+}
+
+// NewValidatingRepository decorates repo so that filters are validated
+// before they are written. WriteBatch is the exception: its only caller,
+// Transfer, validates every entry itself to report on each.
+func NewValidatingRepository(
+	repo repository.GroupNGFilterRepository,
+	validator *Validator,
+) repository.GroupNGFilterRepository {
+	return &validatingRepo{
+		GroupNGFilterRepository: repo,
+		validator:               validator,
+	}
+}
+
+// Create implements repository.GroupNGFilterRepository.
+func (r *validatingRepo) Create(ctx context.Context, ngFilter model.GroupNGFilter) error {
+	if _, err := r.validator.Validate(ngFilter); err != nil {
+		return err
+	}
+	return r.GroupNGFilterRepository.Create(ctx, ngFilter)
+}
+
+// CreateBatch implements repository.GroupNGFilterRepository. Nothing is
+// written unless every filter is valid.
+func (r *validatingRepo) CreateBatch(ctx context.Context, ngFilters []model.GroupNGFilter) error {
+	if err := r.validator.ValidateAll(ngFilters); err != nil {
+		return err
+	}
+	return r.GroupNGFilterRepository.CreateBatch(ctx, ngFilters)
+}
+
+// UpdateByID implements repository.GroupNGFilterRepository. When a $set
+// touches the pattern, flags or action, the stored filter is read and
+// validated with the update applied. Updates touching them any other way
+// are rejected, since they cannot be validated.
+func (r *validatingRepo) UpdateByID(
+	ctx context.Context,
+	ID string,
+	operation interface{},
+) (*model.GroupNGFilter, error) {
+	set, err := validatedFields(operation)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(set) > 0 {
+		ngFilter, err := r.GroupNGFilterRepository.FindOneByConditions(ctx, bson.M{"id": ID}, nil)
+		if err != nil {
+			return nil, err
+		}
+		if pattern, ok := set["pattern"]; ok {
+			ngFilter.Pattern = pattern
+		}
+		if flags, ok := set["flags"]; ok {
+			ngFilter.Flags = flags
+		}
+		if action, ok := set["action"]; ok {
+			ngFilter.Action = model.NGFilterAction(action)
+		}
+
+		if _, err := r.validator.Validate(*ngFilter); err != nil {
+			return nil, err
+		}
+	}
+	return r.GroupNGFilterRepository.UpdateByID(ctx, ID, operation)
+}
+
+// validatedFields returns what operation sets the pattern, flags and
+// action to. It fails when operation cannot be read, or touches one of
+// them with another operator than $set or a value which is not a string.
+func validatedFields(operation interface{}) (map[string]string, error) {
+	op, ok := document(operation)
+	if !ok {
+		return nil, invalid(fmt.Errorf("%w: unsupported operation %T", ErrUncheckedUpdate, operation))
+	}
+
+	set := make(map[string]string)
+	for operator, value := range op {
+		fields, ok := document(value)
+		if !ok {
+			return nil, invalid(fmt.Errorf("%w: unsupported %s %T", ErrUncheckedUpdate, operator, value))
+		}
+
+		for _, field := range []string{"pattern", "flags", "action"} {
+			raw, ok := fields[field]
+			if !ok {
+				continue
+			}
+			if operator != "$set" {
+				return nil, invalid(fmt.Errorf("%w: %s of %s", ErrUncheckedUpdate, operator, field))
+			}
+
+			switch v := raw.(type) {
+			case string:
+				set[field] = v
+			case model.NGFilterAction:
+				set[field] = string(v)
+			default:
+				return nil, invalid(fmt.Errorf("%w: %s set to %T", ErrUncheckedUpdate, field, raw))
+			}
+		}
+	}
+	return set, nil
+}
+
+// document reads the documents an update operation is made of.
+func document(v interface{}) (bson.M, bool) {
+	switch doc := v.(type) {
+	case bson.M:
+		return doc, true
+	case map[string]interface{}:
+		return doc, true
+	case bson.D:
+		m := make(bson.M, len(doc))
+		for _, e := range doc {
+			m[e.Key] = e.Value
+		}
+		return m, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,61 @@
+package ngfilter
+
+import (
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/noxhalley/funken/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestValidatedFields(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation interface{}
+		want      map[string]string
+	}{
+		{"other fields", bson.M{"$set": bson.M{"title": "t"}}, map[string]string{}},
+		{"pattern", bson.M{"$set": bson.M{"pattern": "spam"}}, map[string]string{"pattern": "spam"}},
+		{"typed action", bson.M{"$set": bson.M{"action": model.NGFilterActionMask}}, map[string]string{"action": "mask"}},
+		{"string action", bson.M{"$set": bson.M{"action": "flag"}}, map[string]string{"action": "flag"}},
+		{"plain map", map[string]interface{}{"$set": map[string]interface{}{"flags": "i"}}, map[string]string{"flags": "i"}},
+		{"ordered", bson.D{{Key: "$set", Value: bson.D{{Key: "pattern", Value: "spam"}, {Key: "flags", Value: "w"}}}}, map[string]string{"pattern": "spam", "flags": "w"}},
+		{"other operator", bson.M{"$unset": bson.M{"title": ""}}, map[string]string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validatedFields(tt.operation)
+			if err != nil {
+				t.Fatalf("validatedFields(%v) = %v", tt.operation, err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("validatedFields(%v) = %v, want %v", tt.operation, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidatedFieldsRejects(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation interface{}
+	}{
+		{"pipeline", bson.A{bson.M{"$set": bson.M{"pattern": "spam"}}}},
+		{"unreadable set", bson.M{"$set": []string{"pattern"}}},
+		{"unset pattern", bson.M{"$unset": bson.M{"pattern": ""}}},
+		{"renamed flags", bson.D{{Key: "$rename", Value: bson.D{{Key: "flags", Value: "old_flags"}}}}},
+		{"pattern of wrong type", bson.M{"$set": bson.M{"pattern": 1}}},
+		{"action of wrong type", bson.M{"$set": bson.M{"action": []byte("mask")}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validatedFields(tt.operation)
+			if !errors.Is(err, ErrInvalidFilter) || !errors.Is(err, ErrUncheckedUpdate) {
+				t.Errorf("validatedFields(%v) = %v, want %v", tt.operation, err, ErrUncheckedUpdate)
+			}
+		})
+	}
+}
@@ -0,0 +1,325 @@
+package ngfilter
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+const maxImportEntries = 10000
+
+// Format is the encoding of an imported or exported filter list.
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+)
+
+// ImportMode decides what happens to the filters already in the group.
+type ImportMode string
+
+const (
+	// ImportMerge adds the imported filters whose pattern is new.
+	ImportMerge ImportMode = "merge"
+	// ImportReplace makes the group hold exactly the imported filters.
+	ImportReplace ImportMode = "replace"
+)
+
+var ErrInvalidImport = errors.New("invalid NG filter import")
+
+// Entry is one filter of an exported or imported list. CSV lists carry the
+// same fields as columns, in any order, with a header naming them.
+type Entry struct {
+	Title   string               `json:"title"`
+	Pattern string               `json:"pattern"`
+	Flags   string               `json:"flags,omitempty"`
+	Action  model.NGFilterAction `json:"action,omitempty"`
+}
+
+type ImportInput struct {
+	GroupID string
+	Format  Format
+	Data    io.Reader
+	Mode    ImportMode
+	// DryRun reports what the import would do without writing anything.
+	DryRun bool
+}
+
+// InvalidEntry is an entry rejected by validation. Line is the line of a
+// CSV list, or the 1-based position in a JSON list.
+type InvalidEntry struct {
+	Line    int    `json:"line"`
+	Pattern string `json:"pattern"`
+	Error   string `json:"error"`
+}
+
+type ImportReport struct {
+	Created []model.GroupNGFilter `json:"created"`
+	Updated []model.GroupNGFilter `json:"updated"`
+	Deleted []model.GroupNGFilter `json:"deleted"`
+	// Duplicates are the patterns skipped because the list repeats them or,
+	// when merging, the group already has them.
+	Duplicates []string       `json:"duplicates"`
+	Invalid    []InvalidEntry `json:"invalid"`
+	// Applied is false for a dry run or when an entry is invalid.
+	Applied bool `json:"applied"`
+}
+
+// Transfer imports and exports the filter list of a group, or the global
+// list for an empty group ID.
+type Transfer struct {
+	ngFilterRepo repository.GroupNGFilterRepository
+	validator    *Validator
+}
+
+func NewTransfer(ngFilterRepo repository.GroupNGFilterRepository, validator *Validator) *Transfer {
+	return &Transfer{
+		ngFilterRepo: ngFilterRepo,
+		validator:    validator,
+	}
+}
+
+// Export writes the filters of a group to w.
+func (t *Transfer) Export(ctx context.Context, groupID string, format Format, w io.Writer) error {
+	filters, err := t.groupFilters(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]Entry, len(filters))
+	for i, f := range filters {
+		entries[i] = Entry{
+			Title:   f.Title,
+			Pattern: f.Pattern,
+			Flags:   f.Flags,
+			Action:  f.EffectiveAction(),
+		}
+	}
+
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write([]string{"title", "pattern", "flags", "action"}); err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if err := cw.Write([]string{e.Title, e.Pattern, e.Flags, string(e.Action)}); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	default:
+		return fmt.Errorf("%w: unknown format %q", ErrInvalidImport, format)
+	}
+}
+
+// Import reads a filter list into a group, de-duplicating by pattern. Any
+// invalid entry stops the whole import from being applied, the report then
+// tells which ones.
+//
+// The changes are written in one batch which creates the new filters before
+// updating and deleting the old ones, so a failure halfway leaves the group
+// with too many filters rather than too few.
+func (t *Transfer) Import(ctx context.Context, input ImportInput) (*ImportReport, error) {
+	if input.Mode != ImportMerge && input.Mode != ImportReplace {
+		return nil, fmt.Errorf("%w: unknown mode %q", ErrInvalidImport, input.Mode)
+	}
+
+	entries, lines, err := decodeEntries(input.Format, input.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := t.groupFilters(ctx, input.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	byPattern := make(map[string]model.GroupNGFilter, len(existing))
+	for _, f := range existing {
+		byPattern[f.Pattern] = f
+	}
+
+	report := &ImportReport{
+		Created:    []model.GroupNGFilter{},
+		Updated:    []model.GroupNGFilter{},
+		Deleted:    []model.GroupNGFilter{},
+		Duplicates: []string{},
+		Invalid:    []InvalidEntry{},
+	}
+	now := time.Now()
+	imported := make(map[string]struct{}, len(entries))
+
+	for i, e := range entries {
+		if _, ok := imported[e.Pattern]; ok {
+			report.Duplicates = append(report.Duplicates, e.Pattern)
+			continue
+		}
+		imported[e.Pattern] = struct{}{}
+
+		f := model.GroupNGFilter{
+			BaseModel: model.BaseModel{
+				ID:        uuid.NewString(),
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+			GroupID: input.GroupID,
+			Title:   e.Title,
+			Pattern: e.Pattern,
+			Flags:   e.Flags,
+			Action:  e.Action,
+		}
+		if _, err := t.validator.Validate(f); err != nil {
+			report.Invalid = append(report.Invalid, InvalidEntry{Line: lines[i], Pattern: e.Pattern, Error: err.Error()})
+			continue
+		}
+
+		old, ok := byPattern[e.Pattern]
+		switch {
+		case !ok:
+			report.Created = append(report.Created, f)
+		case input.Mode == ImportMerge:
+			report.Duplicates = append(report.Duplicates, e.Pattern)
+		case old.Title != f.Title || old.Flags != f.Flags || old.EffectiveAction() != f.EffectiveAction():
+			old.Title, old.Flags, old.Action, old.UpdatedAt = f.Title, f.Flags, f.Action, now
+			report.Updated = append(report.Updated, old)
+		}
+	}
+
+	if input.Mode == ImportReplace {
+		for _, f := range existing {
+			if _, ok := imported[f.Pattern]; !ok {
+				report.Deleted = append(report.Deleted, f)
+			}
+		}
+	}
+
+	if input.DryRun || len(report.Invalid) > 0 {
+		return report, nil
+	}
+	batch := repository.NGFilterBatch{
+		Create: report.Created,
+		Update: report.Updated,
+		Delete: report.Deleted,
+	}
+	if err := t.ngFilterRepo.WriteBatch(ctx, batch); err != nil {
+		return nil, err
+	}
+	report.Applied = true
+	return report, nil
+}
+
+func (t *Transfer) groupFilters(ctx context.Context, groupID string) ([]model.GroupNGFilter, error) {
+	if groupID == "" {
+		return t.ngFilterRepo.FindGlobal(ctx)
+	}
+	return t.ngFilterRepo.FindByConditions(ctx, bson.M{"group_id": groupID}, nil)
+}
+
+// decodeEntries parses a filter list and returns, along with each entry,
+// where it came from for the report.
+func decodeEntries(format Format, r io.Reader) ([]Entry, []int, error) {
+	var (
+		entries []Entry
+		lines   []int
+	)
+
+	switch format {
+	case FormatJSON:
+		if err := json.NewDecoder(r).Decode(&entries); err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+		}
+		lines = make([]int, len(entries))
+		for i := range lines {
+			lines[i] = i + 1
+		}
+	case FormatCSV:
+		var err error
+		if entries, lines, err = decodeCSV(r); err != nil {
+			return nil, nil, err
+		}
+	default:
+		return nil, nil, fmt.Errorf("%w: unknown format %q", ErrInvalidImport, format)
+	}
+
+	if len(entries) > maxImportEntries {
+		return nil, nil, fmt.Errorf("%w: more than %d entries", ErrInvalidImport, maxImportEntries)
+	}
+	for i := range entries {
+		e := &entries[i]
+		e.Pattern = strings.TrimSpace(e.Pattern)
+		e.Title = strings.TrimSpace(e.Title)
+		if e.Title == "" {
+			e.Title = e.Pattern
+		}
+	}
+	return entries, lines, nil
+}
+
+func decodeCSV(r io.Reader) ([]Entry, []int, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: missing header: %v", ErrInvalidImport, err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["pattern"]; !ok {
+		return nil, nil, fmt.Errorf("%w: header has no pattern column", ErrInvalidImport)
+	}
+
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return record[i]
+		}
+		return ""
+	}
+
+	var (
+		entries []Entry
+		lines   []int
+	)
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+		}
+		if len(entries) == maxImportEntries {
+			return nil, nil, fmt.Errorf("%w: more than %d entries", ErrInvalidImport, maxImportEntries)
+		}
+
+		line, _ := cr.FieldPos(0)
+		entries = append(entries, Entry{
+			Title:   field(record, "title"),
+			Pattern: field(record, "pattern"),
+			Flags:   field(record, "flags"),
+			Action:  model.NGFilterAction(field(record, "action")),
+		})
+		lines = append(lines, line)
+	}
+	return entries, lines, nil
+}
@@ -0,0 +1,235 @@
+package ngfilter
+
+import (
+	"errors"
+	"fmt"
+	"regexp/syntax"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/noxhalley/funken/config"
+	"github.com/noxhalley/funken/internal/model"
+)
+
+const (
+	// adversarialLength matches the longest message the send path accepts.
+	adversarialLength = 4000
+	// costRuns is how often each input is evaluated, the fastest run being
+	// kept to keep scheduling noise out of the measurement.
+	costRuns = 3
+)
+
+var (
+	// ErrInvalidFilter is wrapped by every validation failure so transports
+	// can map the whole family to a single client error.
+	ErrInvalidFilter       = errors.New("invalid NG filter")
+	ErrInvalidAction       = errors.New("invalid NG filter action")
+	ErrPatternTooExpensive = errors.New("NG filter pattern is too expensive to evaluate")
+	ErrNotGlobal           = errors.New("NG filter is not global")
+	ErrUncheckedUpdate     = errors.New("NG filter update cannot be validated")
+)
+
+// Validator checks filters before they are stored, so that a broken or
+// pathologically slow pattern never reaches the send path.
+type Validator struct {
+	maxProgramSize int
+	normalizer     *Normalizer
+}
+
+func NewValidator(cfg *config.Config, normalizer *Normalizer) *Validator {
+	return &Validator{
+		maxProgramSize: cfg.NGFilter.MaxProgramSize,
+		normalizer:     normalizer,
+	}
+}
+
+// SampleResult is the outcome of a dry run against one sample text.
+type SampleResult struct {
+	Text   string `json:"text"`
+	Spans  []Span `json:"spans"`
+	Masked string `json:"masked"`
+}
+
+type DryRunResult struct {
+	// ProgramSize is the number of instructions of the compiled pattern.
+	ProgramSize int `json:"program_size"`
+	// Cost is the slowest evaluation measured against adversarial inputs.
+	Cost    time.Duration  `json:"cost"`
+	Samples []SampleResult `json:"samples"`
+}
+
+// Validate compiles f and returns the size of its program. Go's regexp
+// runs in time linear in the message times the program size, so there is
+// no catastrophic backtracking to detect, and bounding the program bounds
+// the time a message may take, independently of the machine.
+func (v *Validator) Validate(f model.GroupNGFilter) (int, error) {
+	if strings.TrimSpace(f.Pattern) == "" {
+		return 0, invalid(fmt.Errorf("%w: empty pattern", ErrInvalidPattern))
+	}
+	if f.Action != "" && !f.Action.IsValid() {
+		return 0, invalid(fmt.Errorf("%w: %q", ErrInvalidAction, f.Action))
+	}
+
+	flags, err := ParseFlags(f.Flags)
+	if err != nil {
+		return 0, invalid(err)
+	}
+
+	if _, err := compileRule(f, flags); err != nil {
+		return 0, invalid(err)
+	}
+
+	re, err := syntax.Parse(flags.regexpPrefix()+f.Pattern, syntax.Perl)
+	if err != nil {
+		return 0, invalid(fmt.Errorf("%w: %v", ErrInvalidPattern, err))
+	}
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return 0, invalid(fmt.Errorf("%w: %v", ErrInvalidPattern, err))
+	}
+	// literal patterns go through the automaton, whatever their length
+	if _, _, ok := literalPattern(f.Pattern, flags); !ok && len(prog.Inst) > v.maxProgramSize {
+		return 0, invalid(fmt.Errorf(
+			"%w: program of %d instructions, limit %d",
+			ErrPatternTooExpensive, len(prog.Inst), v.maxProgramSize,
+		))
+	}
+	return len(prog.Inst), nil
+}
+
+// ValidateAll validates every filter and reports all failures together.
+func (v *Validator) ValidateAll(filters []model.GroupNGFilter) error {
+	var errs []error
+	for _, f := range filters {
+		if _, err := v.Validate(f); err != nil {
+			errs = append(errs, fmt.Errorf("filter %q: %w", f.Title, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DryRun validates f and evaluates it against samples without storing
+// anything. It also measures how long the filter takes on inputs crafted
+// from its own pattern, for information only: acceptance only depends on
+// the program size.
+func (v *Validator) DryRun(f model.GroupNGFilter, samples []string) (*DryRunResult, error) {
+	size, err := v.Validate(f)
+	if err != nil {
+		return nil, err
+	}
+
+	set, err := Compile([]model.GroupNGFilter{f}, v.normalizer)
+	if err != nil {
+		return nil, invalid(err)
+	}
+
+	flags, _ := ParseFlags(f.Flags)
+	// Validate already parsed the pattern
+	re, _ := syntax.Parse(flags.regexpPrefix()+f.Pattern, syntax.Perl)
+
+	result := &DryRunResult{
+		ProgramSize: size,
+		Samples:     make([]SampleResult, len(samples)),
+	}
+	for _, input := range adversarialInputs(re) {
+		result.Cost = max(result.Cost, measure(set, input))
+	}
+	for i, text := range samples {
+		matches := set.Evaluate(text)
+
+		sample := SampleResult{Text: text, Spans: []Span{}, Masked: Mask(text, matches)}
+		for _, m := range matches {
+			sample.Spans = append(sample.Spans, m.Spans...)
+		}
+		result.Samples[i] = sample
+	}
+	return result, nil
+}
+
+func invalid(err error) error {
+	return fmt.Errorf("%w: %w", ErrInvalidFilter, err)
+}
+
+func measure(set *Set, input string) time.Duration {
+	fastest := time.Duration(-1)
+	for range costRuns {
+		start := time.Now()
+		set.Evaluate(input)
+		if d := time.Since(start); fastest < 0 || d < fastest {
+			fastest = d
+		}
+	}
+	return fastest
+}
+
+// adversarialInputs builds message sized texts which keep the matcher
+// busy: long runs of the runes the pattern looks for, which produce many
+// partial and failed matches, and the same runs glued to word characters,
+// which defeat whole-word matching after the regexp already matched.
+func adversarialInputs(re *syntax.Regexp) []string {
+	runes := patternRunes(re)
+	if len(runes) == 0 {
+		runes = []rune{'a'}
+	}
+
+	seed := string(runes)
+	inputs := []string{
+		fill(seed),
+		fill(seed + "x"),
+		fill(seed[:len(seed)-utf8.RuneLen(runes[len(runes)-1])]),
+		fill(" " + seed),
+		fill("あ"),
+	}
+	for _, r := range runes[:min(len(runes), 4)] {
+		inputs = append(inputs, fill(string(r)))
+	}
+	return inputs
+}
+
+func fill(seed string) string {
+	if seed == "" {
+		seed = "a"
+	}
+	n := adversarialLength/utf8.RuneCountInString(seed) + 1
+	return string([]rune(strings.Repeat(seed, n))[:adversarialLength])
+}
+
+// patternRunes collects the literal runes of re, plus one representative
+// of each character class, in pattern order.
+func patternRunes(re *syntax.Regexp) []rune {
+	var runes []rune
+	var walk func(*syntax.Regexp)
+	walk = func(re *syntax.Regexp) {
+		switch re.Op {
+		case syntax.OpLiteral:
+			for _, r := range re.Rune {
+				runes = appendEncodable(runes, r)
+			}
+		case syntax.OpCharClass:
+			if len(re.Rune) > 0 {
+				runes = appendEncodable(runes, re.Rune[0])
+			}
+		case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+			runes = append(runes, 'a')
+		}
+		for _, sub := range re.Sub {
+			walk(sub)
+		}
+	}
+	walk(re)
+
+	if len(runes) > 64 {
+		runes = runes[:64]
+	}
+	return runes
+}
+
+// appendEncodable leaves out the runes UTF-8 cannot encode, such as the
+// surrogates a pattern may spell out with \x{D800}, which no text holds.
+func appendEncodable(runes []rune, r rune) []rune {
+	if utf8.RuneLen(r) < 0 {
+		return runes
+	}
+	return append(runes, r)
+}
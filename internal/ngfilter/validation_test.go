@@ -0,0 +1,93 @@
+package ngfilter
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/noxhalley/funken/internal/model"
+)
+
+func newTestValidator() *Validator {
+	return &Validator{maxProgramSize: 400}
+}
+
+func TestValidateAccepts(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		flags   string
+	}{
+		{"literal", "spam", ""},
+		{"case folded literal", "Spam", "i"},
+		{"regexp", `b[a4@]d\s*word`, "iw"},
+		{"long literal", strings.Repeat("abc", 1000), "w"},
+		{"bounded repeat", "x{100}", "w"},
+		{"surrogate", `\x{D800}`, ""},
+	}
+
+	v := newTestValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := model.GroupNGFilter{Pattern: tt.pattern, Flags: tt.flags}
+			if _, err := v.Validate(f); err != nil {
+				t.Errorf("Validate(%q) = %v, want nil", tt.pattern, err)
+			}
+		})
+	}
+}
+
+func TestValidateRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter model.GroupNGFilter
+		want   error
+	}{
+		{"empty pattern", model.GroupNGFilter{Pattern: " "}, ErrInvalidPattern},
+		{"broken pattern", model.GroupNGFilter{Pattern: "("}, ErrInvalidPattern},
+		{"unknown flag", model.GroupNGFilter{Pattern: "spam", Flags: "z"}, ErrInvalidFlag},
+		{"unknown action", model.GroupNGFilter{Pattern: "spam", Action: "ban"}, ErrInvalidAction},
+		{"huge program", model.GroupNGFilter{Pattern: "x{1000}", Flags: "w"}, ErrPatternTooExpensive},
+		{"huge class", model.GroupNGFilter{Pattern: `[a-z]{500}q`}, ErrPatternTooExpensive},
+	}
+
+	v := newTestValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := v.Validate(tt.filter)
+			if !errors.Is(err, ErrInvalidFilter) || !errors.Is(err, tt.want) {
+				t.Errorf("Validate(%q) = %v, want %v", tt.filter.Pattern, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateAllReportsEveryFailure(t *testing.T) {
+	err := newTestValidator().ValidateAll([]model.GroupNGFilter{
+		{Title: "ok", Pattern: "spam"},
+		{Title: "broken", Pattern: "("},
+		{Title: "huge", Pattern: "x{1000}"},
+	})
+	if err == nil {
+		t.Fatal("ValidateAll() = nil, want an error")
+	}
+	for _, title := range []string{`"broken"`, `"huge"`} {
+		if !strings.Contains(err.Error(), title) {
+			t.Errorf("ValidateAll() = %v, want a failure for filter %s", err, title)
+		}
+	}
+	if strings.Contains(err.Error(), `"ok"`) {
+		t.Errorf("ValidateAll() = %v, want no failure for the valid filter", err)
+	}
+}
+
+// TestDryRunUnencodableRunes makes sure patterns spelling out runes UTF-8
+// cannot encode do not break the adversarial inputs.
+func TestDryRunUnencodableRunes(t *testing.T) {
+	for _, pattern := range []string{`\x{D800}`, `[\x{D800}-\x{DFFF}]x`, `a\x{DFFF}`} {
+		f := model.GroupNGFilter{Pattern: pattern}
+		if _, err := newTestValidator().DryRun(f, []string{"sample"}); err != nil {
+			t.Errorf("DryRun(%q) = %v, want nil", pattern, err)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/noxhalley/funken/internal/infrastructure/storage"
+	"github.com/noxhalley/funken/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const (
+	maxAttachmentNameLength = 255
+	// sniffLength is how much of a file content type detection looks at.
+	sniffLength = 512
+)
+
+type UploadAttachmentInput struct {
+	GroupID    string
+	UploaderID string
+	Name       string
+	// ContentType is the type the client declared, only used when it is
+	// a more precise type of the detected content.
+	ContentType string
+	Content     io.Reader
+}
+
+type OpenAttachmentInput struct {
+	ID      string
+	GroupID string
+	// ViewerID must be a member allowed to see the message of the
+	// attachment, or have uploaded it if no message was sent with it yet.
+	ViewerID string
+}
+
+// UploadAttachment stores a file for a member to send with a message. The
+// group's attachment policy bounds its size and content type, which is
+// detected from the content rather than taken from the client.
+func (s *messageService) UploadAttachment(ctx context.Context, input UploadAttachmentInput) (*model.Attachment, error) {
+	name, err := validateAttachmentName(input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.checkCanPost(ctx, input.GroupID, input.UploaderID)
+	if err != nil {
+		return nil, err
+	}
+	maxSize, allowedTypes := s.attachmentLimits(group)
+
+	content := bufio.NewReaderSize(input.Content, sniffLength)
+	head, err := content.Peek(sniffLength)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if len(head) == 0 {
+		return nil, fmt.Errorf("%w: attachment must not be empty", ErrInvalidInput)
+	}
+
+	mimeType := detectMIMEType(head, input.ContentType)
+	if !slices.ContainsFunc(allowedTypes, func(pattern string) bool { return matchMIMEType(pattern, mimeType) }) {
+		return nil, fmt.Errorf("%w: content type %s is not allowed", ErrInvalidInput, mimeType)
+	}
+
+	now := time.Now()
+	attachment := model.Attachment{
+		BaseModel: model.BaseModel{
+			ID:        uuid.NewString(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		GroupID:    input.GroupID,
+		UploaderID: input.UploaderID,
+		Name:       name,
+		MIMEType:   mimeType,
+	}
+
+	hash := sha256.New()
+	limited := &sizeLimitedReader{r: content, max: maxSize}
+	if err := s.storage.Put(ctx, attachment.ID, io.TeeReader(limited, hash)); err != nil {
+		if errors.Is(err, ErrAttachmentTooLarge) {
+			return nil, fmt.Errorf("%w: attachments may be at most %d bytes", ErrAttachmentTooLarge, maxSize)
+		}
+		return nil, err
+	}
+	attachment.Size = limited.n
+	attachment.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	if err := s.attachmentRepo.Create(ctx, attachment); err != nil {
+		s.deleteContent(ctx, attachment.ID)
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+// OpenAttachment returns an attachment with its content, which the caller
+// must close.
+func (s *messageService) OpenAttachment(
+	ctx context.Context,
+	input OpenAttachmentInput,
+) (*model.Attachment, io.ReadCloser, error) {
+	filter := bson.M{"id": input.ID, "group_id": input.GroupID}
+	attachment, err := s.attachmentRepo.FindOneByConditions(ctx, filter, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if attachment.MessageID == "" {
+		if attachment.UploaderID != input.ViewerID {
+			return nil, nil, mongo.ErrNoDocuments
+		}
+	} else {
+		msg, err := s.findReadable(ctx, attachment.MessageID, input.GroupID, input.ViewerID)
+		if err != nil {
+			return nil, nil, err
+		}
+		// findReadable trusts callers without a viewer, but they are not
+		// the sender either
+		if msg.IsShadowed() && msg.SenderID != input.ViewerID {
+			return nil, nil, mongo.ErrNoDocuments
+		}
+	}
+
+	content, err := s.storage.Get(ctx, attachment.ID)
+	if errors.Is(err, storage.ErrNotFound) {
+		s.logger.Warn(ctx, "attachment content is missing", "attachment_id", attachment.ID)
+		return nil, nil, mongo.ErrNoDocuments
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return attachment, content, nil
+}
+
+// validateAttachmentIDs drops duplicates and enforces the number of
+// attachments a message may have.
+func (s *messageService) validateAttachmentIDs(IDs []string) ([]string, error) {
+	unique := make([]string, 0, len(IDs))
+	for _, ID := range IDs {
+		if ID != "" && !slices.Contains(unique, ID) {
+			unique = append(unique, ID)
+		}
+	}
+	if len(unique) > s.maxAttachments {
+		return nil, fmt.Errorf("%w: a message may have at most %d attachments", ErrInvalidInput, s.maxAttachments)
+	}
+	return unique, nil
+}
+
+// claimAttachments attaches the uploads of the sender to msg, all of them
+// or none.
+func (s *messageService) claimAttachments(ctx context.Context, msg *model.Message, IDs []string) error {
+	attachments, err := s.attachmentRepo.Claim(ctx, IDs, msg.GroupID, msg.SenderID, msg.ID)
+	if err != nil {
+		return err
+	}
+	if len(attachments) < len(IDs) {
+		s.releaseAttachments(ctx, msg.ID)
+		return fmt.Errorf("%w: attachment not found or already sent", ErrInvalidInput)
+	}
+
+	msg.Attachments = attachments
+	return nil
+}
+
+func (s *messageService) releaseAttachments(ctx context.Context, messageID string) {
+	if err := s.attachmentRepo.Release(ctx, messageID); err != nil {
+		s.logger.Warn(ctx, "failed to release attachments", "message_id", messageID, "error", err)
+	}
+}
+
+func (s *messageService) deleteContent(ctx context.Context, key string) {
+	if err := s.storage.Delete(ctx, key); err != nil {
+		s.logger.Warn(ctx, "failed to delete attachment content", "attachment_id", key, "error", err)
+	}
+}
+
+// attachmentLimits returns the maximum size and allowed content types of
+// the group's attachments, its own policy taking over the defaults.
+func (s *messageService) attachmentLimits(group *model.Group) (int64, []string) {
+	maxSize, allowedTypes := s.maxAttachmentSize, s.allowedAttachmentTypes
+	if p := group.AttachmentPolicy; p != nil {
+		if p.MaxSize > 0 {
+			maxSize = p.MaxSize
+		}
+		if len(p.AllowedTypes) > 0 {
+			allowedTypes = p.AllowedTypes
+		}
+	}
+	return maxSize, allowedTypes
+}
+
+func validateAttachmentName(raw string) (string, error) {
+	name := strings.TrimSpace(raw)
+	if name == "" {
+		return "", fmt.Errorf("%w: attachment name must not be empty", ErrInvalidInput)
+	}
+	if utf8.RuneCountInString(name) > maxAttachmentNameLength {
+		return "", fmt.Errorf("%w: attachment name exceeds %d characters", ErrInvalidInput, maxAttachmentNameLength)
+	}
+	if strings.ContainsFunc(name, func(r rune) bool { return r == '/' || r == '\\' || unicode.IsControl(r) }) {
+		return "", fmt.Errorf("%w: attachment name must not contain paths", ErrInvalidInput)
+	}
+	return name, nil
+}
+
+// detectMIMEType sniffs the content type from the head of a file. Content
+// which is not recognized stays application/octet-stream, and the declared
+// type is only taken when it refines the sniffed one, as text/csv does for
+// text/plain, so that a label cannot get other content past the allow-list.
+func detectMIMEType(head []byte, declared string) string {
+	detected, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	declared, _, err := mime.ParseMediaType(declared)
+	if err != nil {
+		return detected
+	}
+	if detected == "text/plain" && strings.HasPrefix(declared, "text/") {
+		return declared
+	}
+	return detected
+}
+
+// matchMIMEType matches mimeType against a type of an allow-list, where
+// "image/*" stands for every image type.
+func matchMIMEType(pattern, mimeType string) bool {
+	pattern = strings.ToLower(pattern)
+	if prefix, ok := strings.CutSuffix(pattern, "/*"); ok {
+		return strings.HasPrefix(mimeType, prefix+"/")
+	}
+	return pattern == mimeType
+}
+
+// sizeLimitedReader fails with ErrAttachmentTooLarge once more than max
+// bytes were read, and counts them.
+type sizeLimitedReader struct {
+	r   io.Reader
+	max int64
+	n   int64
+}
+
+func (l *sizeLimitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.n += int64(n)
+	if l.n > l.max {
+		return 0, ErrAttachmentTooLarge
+	}
+	return n, err
+}
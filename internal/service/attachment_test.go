@@ -0,0 +1,93 @@
+package service
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestDetectMIMEType(t *testing.T) {
+	tests := []struct {
+		name     string
+		head     string
+		declared string
+		want     string
+	}{
+		{"png", "\x89PNG\r\n\x1a\n", "image/png", "image/png"},
+		{"png declared as jpeg", "\x89PNG\r\n\x1a\n", "image/jpeg", "image/png"},
+		{"pdf", "%PDF-1.7", "", "application/pdf"},
+		{"binary", "\x00\x01\x02\x03", "image/png", "application/octet-stream"},
+		{"text", "hello", "", "text/plain"},
+		{"declared text type", "a,b\n1,2\n", "text/csv", "text/csv"},
+		{"declared text with parameters", "# title", "Text/Markdown; charset=utf-8", "text/markdown"},
+		{"declared other type", `{"a":1}`, "application/json", "text/plain"},
+		{"malformed declared type", "hello", "text/", "text/plain"},
+		{"html declared as text", "<html><body>", "text/plain", "text/html"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := detectMIMEType([]byte(tt.head), tt.declared); got != tt.want {
+				t.Errorf("detectMIMEType(%q, %q) = %q, want %q", tt.head, tt.declared, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchMIMEType(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		mimeType string
+		want     bool
+	}{
+		{"exact", "image/png", "image/png", true},
+		{"other type", "image/png", "image/jpeg", false},
+		{"case", "Image/PNG", "image/png", true},
+		{"wildcard", "image/*", "image/webp", true},
+		{"wildcard other type", "image/*", "video/mp4", false},
+		{"wildcard prefix only", "image/*", "imagex/png", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchMIMEType(tt.pattern, tt.mimeType); got != tt.want {
+				t.Errorf("matchMIMEType(%q, %q) = %v, want %v", tt.pattern, tt.mimeType, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSizeLimitedReader(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    int
+		max     int64
+		wantErr error
+	}{
+		{"empty", 0, 16, nil},
+		{"below", 10, 16, nil},
+		{"at limit", 16, 16, nil},
+		{"above", 17, 16, ErrAttachmentTooLarge},
+		{"far above", 1 << 16, 16, ErrAttachmentTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := strings.Repeat("x", tt.size)
+			r := &sizeLimitedReader{r: strings.NewReader(content), max: tt.max}
+
+			got, err := io.ReadAll(r)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReadAll() = %v, want %v", err, tt.wantErr)
+			}
+			if int64(len(got)) > tt.max {
+				t.Errorf("ReadAll() read %d bytes, want at most %d", len(got), tt.max)
+			}
+			if err == nil && (string(got) != content || r.n != int64(tt.size)) {
+				t.Errorf("ReadAll() = %d bytes, counted %d, want %d", len(got), r.n, tt.size)
+			}
+		})
+	}
+}
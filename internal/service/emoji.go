@@ -0,0 +1,107 @@
+package service
+
+import "unicode"
+
+const (
+	zeroWidthJoiner   = '\u200d'
+	variationSelector = '\ufe0f'
+	combiningKeycap   = '\u20e3'
+	cancelTag         = '\U000e007f'
+)
+
+// pictographs holds the characters emoji sequences are built from. It
+// follows the Extended_Pictographic property of Unicode, which the unicode
+// package does not carry, loosely enough to take emojis added later.
+var pictographs = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00a9, Hi: 0x00ae, Stride: 5},
+		{Lo: 0x203c, Hi: 0x2049, Stride: 13},
+		{Lo: 0x2122, Hi: 0x2139, Stride: 23},
+		{Lo: 0x2194, Hi: 0x2199, Stride: 1},
+		{Lo: 0x21a9, Hi: 0x21aa, Stride: 1},
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x2328, Hi: 0x2388, Stride: 96},
+		{Lo: 0x23cf, Hi: 0x23cf, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x23f8, Hi: 0x23fa, Stride: 1},
+		{Lo: 0x24c2, Hi: 0x24c2, Stride: 1},
+		{Lo: 0x25aa, Hi: 0x25ab, Stride: 1},
+		{Lo: 0x25b6, Hi: 0x25c0, Stride: 10},
+		{Lo: 0x25fb, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2600, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+		{Lo: 0x2b05, Hi: 0x2b07, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b55, Stride: 5},
+		{Lo: 0x3030, Hi: 0x303d, Stride: 13},
+		{Lo: 0x3297, Hi: 0x3299, Stride: 2},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f000, Hi: 0x1f1e5, Stride: 1},
+		{Lo: 0x1f200, Hi: 0x1faff, Stride: 1},
+		{Lo: 0x1fc00, Hi: 0x1fffd, Stride: 1},
+	},
+}
+
+// isEmoji reports whether s is a single emoji: a keycap, a flag, or
+// pictographs joined by zero width joiners, each optionally followed by a
+// variation selector, a skin tone and tags.
+func isEmoji(s string) bool {
+	runes := []rune(s)
+	switch {
+	case len(runes) == 2 && isRegionalIndicator(runes[0]) && isRegionalIndicator(runes[1]):
+		return true
+	case isKeycap(runes):
+		return true
+	}
+
+	for i := 0; ; i++ {
+		// a skin tone only modifies the pictograph before it
+		if i == len(runes) || !unicode.Is(pictographs, runes[i]) || isSkinTone(runes[i]) {
+			return false
+		}
+		i++
+		if i < len(runes) && runes[i] == variationSelector {
+			i++
+		}
+		if i < len(runes) && isSkinTone(runes[i]) {
+			i++
+		}
+		if i < len(runes) && isTag(runes[i]) {
+			for i < len(runes) && isTag(runes[i]) {
+				i++
+			}
+			if i == len(runes) || runes[i] != cancelTag {
+				return false
+			}
+			i++
+		}
+
+		if i == len(runes) {
+			return true
+		}
+		if runes[i] != zeroWidthJoiner {
+			return false
+		}
+	}
+}
+
+func isKeycap(runes []rune) bool {
+	if len(runes) == 3 && runes[1] == variationSelector {
+		runes = []rune{runes[0], runes[2]}
+	}
+	return len(runes) == 2 && runes[1] == combiningKeycap &&
+		(runes[0] == '#' || runes[0] == '*' || '0' <= runes[0] && runes[0] <= '9')
+}
+
+func isRegionalIndicator(r rune) bool {
+	return 0x1f1e6 <= r && r <= 0x1f1ff
+}
+
+func isSkinTone(r rune) bool {
+	return 0x1f3fb <= r && r <= 0x1f3ff
+}
+
+func isTag(r rune) bool {
+	return 0xe0020 <= r && r <= 0xe007e
+}
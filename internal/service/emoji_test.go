@@ -0,0 +1,89 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestIsEmoji(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{"pictograph", "\U0001f44d", true},
+		{"skin tone", "\U0001f44d\U0001f3fd", true},
+		{"variation selector", "\u2764\ufe0f", true},
+		{"joined", "\U0001f468\u200d\U0001f469\u200d\U0001f467", true},
+		{"joined with selector", "\U0001f3f3\ufe0f\u200d\U0001f308", true},
+		{"flag", "\U0001f1ef\U0001f1f5", true},
+		{"keycap", "1\ufe0f\u20e3", true},
+		{"bare keycap", "#\u20e3", true},
+		{"tag sequence", "\U0001f3f4\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", true},
+		{"empty", "", false},
+		{"letter", "a", false},
+		{"digit", "1", false},
+		{"two emojis", "\U0001f44d\U0001f44d", false},
+		{"trailing joiner", "\U0001f44d\u200d", false},
+		{"trailing letter", "\U0001f44dx", false},
+		{"lone regional indicator", "\U0001f1ef", false},
+		{"lone skin tone", "\U0001f3fd", false},
+		{"unterminated tags", "\U0001f3f4\U000e0067\U000e0062", false},
+		{"letter keycap", "a\u20e3", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isEmoji(tt.s); got != tt.want {
+				t.Errorf("isEmoji(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateEmoji(t *testing.T) {
+	tests := []struct {
+		name    string
+		allowed []string
+		raw     string
+		want    string
+	}{
+		{"any emoji", nil, "\U0001f44d", "\U0001f44d"},
+		{"trimmed", nil, " \U0001f44d\n", "\U0001f44d"},
+		{"allowed", []string{"\U0001f44d", "\u2764\ufe0f"}, "\u2764\ufe0f", "\u2764\ufe0f"},
+		{"allowed text", []string{"+1"}, "+1", "+1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &messageService{allowedEmojis: tt.allowed}
+			got, err := s.validateEmoji(tt.raw)
+			if err != nil || got != tt.want {
+				t.Errorf("validateEmoji(%q) = %q, %v, want %q", tt.raw, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateEmojiRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		allowed []string
+		raw     string
+	}{
+		{"empty", nil, " "},
+		{"text", nil, "+1"},
+		{"too long", nil, strings.Repeat("\U0001f468\u200d", 10) + "\U0001f468"},
+		{"not allowed", []string{"\U0001f44d"}, "\u2764\ufe0f"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &messageService{allowedEmojis: tt.allowed}
+			if _, err := s.validateEmoji(tt.raw); !errors.Is(err, ErrInvalidInput) {
+				t.Errorf("validateEmoji(%q) = %v, want %v", tt.raw, err, ErrInvalidInput)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/noxhalley/funken/internal/ngfilter"
+)
+
+var (
+	// ErrInvalidInput is wrapped by every validation failure so transports
+	// can map the whole family to a single client error.
+	ErrInvalidInput    = errors.New("invalid input")
+	ErrNotMember       = errors.New("member does not belong to the group")
+	ErrNotSender       = errors.New("member is not the sender of the message")
+	ErrGroupLocked     = errors.New("group is locked")
+	ErrEditConflict    = errors.New("message was changed concurrently")
+	ErrNGFilterMatched = errors.New("message matched NG filters")
+	// ErrAttachmentTooLarge rejects uploads beyond the size limit of
+	// their group.
+	ErrAttachmentTooLarge = errors.New("attachment is too large")
+)
+
+// NGFilterError rejects a message and carries the filters it matched.
+type NGFilterError struct {
+	Matches []ngfilter.Match
+}
+
+func (e *NGFilterError) Error() string {
+	titles := make([]string, len(e.Matches))
+	for i, m := range e.Matches {
+		titles[i] = m.Title
+	}
+	return ErrNGFilterMatched.Error() + ": " + strings.Join(titles, ", ")
+}
+
+func (e *NGFilterError) Unwrap() error {
+	return ErrNGFilterMatched
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// mentionEveryone mentions every member of the group but the sender.
+const mentionEveryone = "everyone"
+
+// mentionPattern finds @handle tokens. The @ must not follow a word
+// character, so that e-mail addresses are not taken for mentions.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@])@([\w][\w.\-]*)`)
+
+// parseMentions returns the handles mentioned in text, in order of first
+// appearance, and whether it mentions everyone. A handle is a member ID.
+func parseMentions(text string) ([]string, bool) {
+	var (
+		handles  []string
+		everyone bool
+		seen     = map[string]struct{}{}
+	)
+
+	for _, m := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		// a trailing dot ends the sentence rather than the handle
+		handle := strings.TrimRight(m[1], ".")
+		if handle == mentionEveryone {
+			everyone = true
+			continue
+		}
+		if _, ok := seen[handle]; ok {
+			continue
+		}
+		seen[handle] = struct{}{}
+		handles = append(handles, handle)
+	}
+	return handles, everyone
+}
+
+// resolveMentions merges the mentions a client sent along with the ones
+// written in text, and keeps the members of the group among them. More
+// than maxMentions members, which @everyone easily reaches, is rejected.
+func (s *messageService) resolveMentions(
+	ctx context.Context,
+	groupID string,
+	senderID string,
+	text string,
+	mentions []string,
+) ([]string, error) {
+	handles, everyone := parseMentions(text)
+
+	if everyone {
+		count, err := s.memberGroupRepo.CountMembersByGroupID(ctx, groupID)
+		if err != nil {
+			return nil, err
+		}
+		// the sender is a member too, but not mentioned
+		if count-1 > int64(s.maxMentions) {
+			return nil, s.tooManyMentions()
+		}
+
+		memberIDs, err := s.memberGroupRepo.FindMemberIDsByGroupID(ctx, groupID)
+		if err != nil {
+			return nil, err
+		}
+		return withoutMember(memberIDs, senderID), nil
+	}
+
+	seen := make(map[string]struct{}, len(mentions)+len(handles))
+	candidates := make([]string, 0, len(mentions)+len(handles))
+	for i, id := range slices.Concat(mentions, handles) {
+		if _, ok := seen[id]; ok || id == "" {
+			continue
+		}
+		// the client sends member IDs, so more of them than may be
+		// mentioned is rejected before looking them up
+		if i < len(mentions) && len(candidates) == s.maxMentions {
+			return nil, s.tooManyMentions()
+		}
+		seen[id] = struct{}{}
+		candidates = append(candidates, id)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	memberIDs, err := s.memberGroupRepo.FilterMemberIDs(ctx, groupID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	if len(memberIDs) > s.maxMentions {
+		return nil, s.tooManyMentions()
+	}
+
+	// keep the order the mentions were written in
+	isMember := make(map[string]struct{}, len(memberIDs))
+	for _, id := range memberIDs {
+		isMember[id] = struct{}{}
+	}
+	resolved := make([]string, 0, len(memberIDs))
+	for _, id := range candidates {
+		if _, ok := isMember[id]; ok {
+			resolved = append(resolved, id)
+		}
+	}
+	return resolved, nil
+}
+
+func (s *messageService) tooManyMentions() error {
+	return fmt.Errorf("%w: a message may mention at most %d members", ErrInvalidInput, s.maxMentions)
+}
+
+func withoutMember(memberIDs []string, memberID string) []string {
+	res := make([]string, 0, len(memberIDs))
+	for _, id := range memberIDs {
+		if id != memberID {
+			res = append(res, id)
+		}
+	}
+	return res
+}
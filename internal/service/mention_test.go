@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name         string
+		text         string
+		want         []string
+		wantEveryone bool
+	}{
+		{"none", "hello there", nil, false},
+		{"single", "hi @alice", []string{"alice"}, false},
+		{"in order", "@bob and @alice", []string{"bob", "alice"}, false},
+		{"duplicates", "@alice @bob @alice", []string{"alice", "bob"}, false},
+		{"end of sentence", "thanks @alice.", []string{"alice"}, false},
+		{"dotted handle", "@alice.smith-2 joined", []string{"alice.smith-2"}, false},
+		{"punctuation", "(@alice), @bob!", []string{"alice", "bob"}, false},
+		{"e-mail address", "mail alice@example.com", nil, false},
+		{"double at", "@@alice", nil, false},
+		{"bare at", "@ alice", nil, false},
+		{"everyone", "@everyone look", nil, true},
+		{"everyone and handles", "@alice @everyone", []string{"alice"}, true},
+		{"non ASCII handle", "@アリス さん", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, everyone := parseMentions(tt.text)
+			if !slices.Equal(got, tt.want) || everyone != tt.wantEveryone {
+				t.Errorf("parseMentions(%q) = %q, %v, want %q, %v", tt.text, got, everyone, tt.want, tt.wantEveryone)
+			}
+		})
+	}
+}
+
+// fakeMembers serves the member lookups of resolveMentions from a fixed
+// member list.
+type fakeMembers struct {
+	repository.MemberGroupRepository
+	members []string
+	lookups int
+}
+
+func (f *fakeMembers) CountMembersByGroupID(ctx context.Context, groupID string) (int64, error) {
+	return int64(len(f.members)), nil
+}
+
+func (f *fakeMembers) FindMemberIDsByGroupID(ctx context.Context, groupID string) ([]string, error) {
+	f.lookups++
+	return f.members, nil
+}
+
+func (f *fakeMembers) FilterMemberIDs(ctx context.Context, groupID string, memberIDs []string) ([]string, error) {
+	f.lookups++
+	var res []string
+	for _, id := range f.members {
+		if slices.Contains(memberIDs, id) {
+			res = append(res, id)
+		}
+	}
+	return res, nil
+}
+
+func TestResolveMentions(t *testing.T) {
+	members := []string{"alice", "bob", "carol", "dave"}
+	tests := []struct {
+		name     string
+		members  []string
+		text     string
+		mentions []string
+		want     []string
+	}{
+		{"none", members, "hello", nil, nil},
+		{"written", members, "@carol @alice", nil, []string{"carol", "alice"}},
+		{"sent", members, "hello", []string{"bob"}, []string{"bob"}},
+		{"sent first", members, "@alice", []string{"bob", "alice"}, []string{"bob", "alice"}},
+		{"non members", members, "@zed @bob", []string{"yan"}, []string{"bob"}},
+		{"empty sent", members, "hello", []string{""}, nil},
+		{"everyone", members, "@everyone", nil, []string{"bob", "carol", "dave"}},
+		{"too many handles of non members", members, "@v @w @x @y @z @bob", nil, []string{"bob"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &messageService{maxMentions: 3, memberGroupRepo: &fakeMembers{members: tt.members}}
+			got, err := s.resolveMentions(context.Background(), "g", "alice", tt.text, tt.mentions)
+			if err != nil {
+				t.Fatalf("resolveMentions(%q, %q) = %v", tt.text, tt.mentions, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("resolveMentions(%q, %q) = %q, want %q", tt.text, tt.mentions, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveMentionsRejects(t *testing.T) {
+	tests := []struct {
+		name        string
+		members     []string
+		text        string
+		mentions    []string
+		wantLookups int
+	}{
+		{"everyone in a large group", []string{"alice", "bob", "carol", "dave", "erin"}, "@everyone", nil, 0},
+		{"too many sent", []string{"alice"}, "hello", []string{"a", "b", "c", "d"}, 0},
+		{"too many members", []string{"alice", "bob", "carol", "dave", "erin"}, "@bob @carol @dave @erin", nil, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeMembers{members: tt.members}
+			s := &messageService{maxMentions: 3, memberGroupRepo: repo}
+			_, err := s.resolveMentions(context.Background(), "g", "alice", tt.text, tt.mentions)
+			if !errors.Is(err, ErrInvalidInput) {
+				t.Errorf("resolveMentions(%q, %q) = %v, want %v", tt.text, tt.mentions, err, ErrInvalidInput)
+			}
+			if repo.lookups != tt.wantLookups {
+				t.Errorf("resolveMentions(%q, %q) looked members up %d times, want %d", tt.text, tt.mentions, repo.lookups, tt.wantLookups)
+			}
+		})
+	}
+}
@@ -0,0 +1,1031 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/noxhalley/funken/config"
+	"github.com/noxhalley/funken/internal/event"
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/infrastructure/pubsub"
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/infrastructure/storage"
+	"github.com/noxhalley/funken/internal/model"
+	"github.com/noxhalley/funken/internal/ngfilter"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const (
+	maxMessageLength = 4000
+	maxEmojiLength   = 64
+	// maxUnreadCount caps unread counts, clients show it as "999+" or
+	// alike rather than an exact number.
+	maxUnreadCount = 1000
+)
+
+type SendMessageInput struct {
+	GroupID   string
+	SenderID  string
+	Message   string
+	Mentions  []string
+	Priority  bool
+	Nickname  string
+	IPAddress string
+	// ParentID makes the message a reply in the thread of that top-level
+	// message.
+	ParentID string
+	// AttachmentIDs, when set, sends the attachments the sender uploaded
+	// with the message.
+	AttachmentIDs []string
+}
+
+type ListHistoryInput struct {
+	GroupID string
+	// Direction only applies to the first page, a cursor carries its own.
+	Direction model.MsgSortDirection
+	Cursor    string
+	Limit     int64
+	// ViewerID, when set, must be a member of the group. They see their
+	// own shadowed messages, which are hidden from everyone else.
+	ViewerID string
+	// Flagged lists only messages flagged for moderator review.
+	Flagged bool
+	// IncludeDeleted also lists soft-deleted messages.
+	IncludeDeleted bool
+	// ThreadID lists the replies of that thread instead of the group's
+	// top-level messages.
+	ThreadID string
+}
+
+type ListThreadsInput struct {
+	GroupID string
+	Cursor  string
+	Limit   int64
+	// ViewerID, when set, must be a member of the group.
+	ViewerID string
+}
+
+type DeleteMessageInput struct {
+	ID string
+	// GroupID, when set, must be the group of the message.
+	GroupID string
+	// ActorID, when set, must be the sender of the message. Moderation
+	// tools leave it empty.
+	ActorID string
+}
+
+type EditMessageInput struct {
+	ID string
+	// GroupID, when set, must be the group of the message.
+	GroupID string
+	// EditorID must be the sender of the message.
+	EditorID string
+	Message  string
+}
+
+type ListRevisionsInput struct {
+	ID string
+	// GroupID, when set, must be the group of the message.
+	GroupID string
+	// ViewerID, when set, does not find shadowed messages of other senders.
+	ViewerID string
+}
+
+type ReactionInput struct {
+	MessageID string
+	// GroupID, when set, must be the group of the message.
+	GroupID  string
+	MemberID string
+	Emoji    string
+}
+
+type ListReactionsInput struct {
+	ID string
+	// GroupID, when set, must be the group of the message.
+	GroupID string
+	// ViewerID, when set, does not find shadowed messages of other senders.
+	ViewerID string
+}
+
+// ReactionChange is the outcome of adding or removing a reaction, and the
+// payload of the event announcing it.
+type ReactionChange struct {
+	MessageID string `json:"message_id"`
+	MemberID  string `json:"member_id"`
+	Emoji     string `json:"emoji"`
+	// Count is the number of members left reacting with Emoji.
+	Count int64 `json:"count"`
+	// Changed is false when the reaction already was as requested.
+	Changed bool `json:"changed"`
+}
+
+type MarkReadInput struct {
+	GroupID   string
+	MemberID  string
+	MessageID string
+}
+
+type ListReadersInput struct {
+	ID string
+	// GroupID, when set, must be the group of the message.
+	GroupID string
+	// ViewerID, when set, does not find shadowed messages of other senders.
+	ViewerID string
+}
+
+type SetMuteInput struct {
+	GroupID  string
+	MemberID string
+	Muted    bool
+	// Until, when set, ends the mute at that time.
+	Until *time.Time
+}
+
+// ReadReceipt is the payload of the event telling a group how far one of
+// its members has read.
+type ReadReceipt struct {
+	MemberID  string    `json:"member_id"`
+	MessageID string    `json:"message_id"`
+	ReadAt    time.Time `json:"read_at"`
+}
+
+type HistoryPage struct {
+	Messages   []model.Message
+	NextCursor string
+	PrevCursor string
+}
+
+type ThreadPage struct {
+	Threads    []model.Message
+	NextCursor string
+}
+
+type MessageService interface {
+	Send(ctx context.Context, input SendMessageInput) (*model.Message, error)
+
+	ListHistory(ctx context.Context, input ListHistoryInput) (*HistoryPage, error)
+
+	ListThreads(ctx context.Context, input ListThreadsInput) (*ThreadPage, error)
+
+	Delete(ctx context.Context, input DeleteMessageInput) (*model.Message, error)
+
+	Restore(ctx context.Context, ID string) (*model.Message, error)
+
+	Edit(ctx context.Context, input EditMessageInput) (*model.Message, error)
+
+	ListRevisions(ctx context.Context, input ListRevisionsInput) ([]model.MessageRevision, error)
+
+	AddReaction(ctx context.Context, input ReactionInput) (*ReactionChange, error)
+
+	RemoveReaction(ctx context.Context, input ReactionInput) (*ReactionChange, error)
+
+	ListReactions(ctx context.Context, input ListReactionsInput) ([]repository.ReactionSummary, error)
+
+	MarkRead(ctx context.Context, input MarkReadInput) (*model.MemberGroup, error)
+
+	UnreadCounts(ctx context.Context, memberID string) ([]repository.UnreadCount, error)
+
+	ListReaders(ctx context.Context, input ListReadersInput) ([]string, error)
+
+	SetMute(ctx context.Context, input SetMuteInput) (*model.MemberGroup, error)
+
+	Search(ctx context.Context, input SearchInput) (*SearchPage, error)
+
+	UploadAttachment(ctx context.Context, input UploadAttachmentInput) (*model.Attachment, error)
+
+	OpenAttachment(ctx context.Context, input OpenAttachmentInput) (*model.Attachment, io.ReadCloser, error)
+}
+
+type messageService struct {
+	logger                 *log.Logger
+	maxMentions            int
+	allowedEmojis          []string
+	maxAttachments         int
+	maxAttachmentSize      int64
+	allowedAttachmentTypes []string
+	groupRepo              repository.GroupRepository
+	memberGroupRepo        repository.MemberGroupRepository
+	messageRepo            repository.MessageRepository
+	messageRevisionRepo    repository.MessageRevisionRepository
+	messageReactionRepo    repository.MessageReactionRepository
+	publisher              pubsub.Publisher
+	ngFilterEngine         ngfilter.Engine
+	ngFilterHitRepo        repository.NGFilterHitRepository
+	attachmentRepo         repository.AttachmentRepository
+	storage                storage.Storage
+}
+
+func NewMessageService(
+	cfg *config.Config,
+	groupRepo repository.GroupRepository,
+	memberGroupRepo repository.MemberGroupRepository,
+	messageRepo repository.MessageRepository,
+	messageRevisionRepo repository.MessageRevisionRepository,
+	messageReactionRepo repository.MessageReactionRepository,
+	publisher pubsub.Publisher,
+	ngFilterEngine ngfilter.Engine,
+	ngFilterHitRepo repository.NGFilterHitRepository,
+	attachmentRepo repository.AttachmentRepository,
+	storage storage.Storage,
+) MessageService {
+	return &messageService{
+		logger:                 log.With("service", "message_service"),
+		maxMentions:            cfg.Mention.MaxMentions,
+		allowedEmojis:          cfg.Reaction.AllowedEmojis,
+		maxAttachments:         cfg.Attachment.MaxPerMessage,
+		maxAttachmentSize:      cfg.Attachment.MaxSize,
+		allowedAttachmentTypes: cfg.Attachment.AllowedTypes,
+		groupRepo:              groupRepo,
+		memberGroupRepo:        memberGroupRepo,
+		messageRepo:            messageRepo,
+		messageRevisionRepo:    messageRevisionRepo,
+		messageReactionRepo:    messageReactionRepo,
+		publisher:              publisher,
+		ngFilterEngine:         ngFilterEngine,
+		ngFilterHitRepo:        ngFilterHitRepo,
+		attachmentRepo:         attachmentRepo,
+		storage:                storage,
+	}
+}
+
+// Send persists a message and publishes it on the group subject. The
+// database is the source of truth: a failed publish is logged rather than
+// returned, since the message is already visible through history.
+func (s *messageService) Send(ctx context.Context, input SendMessageInput) (*model.Message, error) {
+	attachmentIDs, err := s.validateAttachmentIDs(input.AttachmentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := validateText(input.Message, len(attachmentIDs) > 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.checkCanPost(ctx, input.GroupID, input.SenderID); err != nil {
+		return nil, err
+	}
+
+	if input.ParentID != "" {
+		if _, err := s.findThread(ctx, input.GroupID, input.ParentID, input.SenderID); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, fmt.Errorf("%w: parent message not found", ErrInvalidInput)
+			}
+			return nil, err
+		}
+	}
+
+	result, err := s.ngFilterEngine.Evaluate(ctx, input.GroupID, text)
+	if err != nil {
+		return nil, err
+	}
+	text, moderation, err := moderate(text, result)
+	if err != nil {
+		s.recordHits(ctx, input.GroupID, input.SenderID, "", result)
+		return nil, err
+	}
+
+	// parsed after masking, which may have hidden some of them
+	mentions, err := s.resolveMentions(ctx, input.GroupID, input.SenderID, text, input.Mentions)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	msg := model.Message{
+		BaseModel: model.BaseModel{
+			ID:        uuid.NewString(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		Message:    text,
+		GroupID:    input.GroupID,
+		SenderID:   input.SenderID,
+		Mentions:   mentions,
+		Priority:   input.Priority,
+		Nickname:   input.Nickname,
+		IPAddress:  input.IPAddress,
+		ParentID:   input.ParentID,
+		Moderation: moderation,
+	}
+
+	if len(attachmentIDs) > 0 {
+		if err := s.claimAttachments(ctx, &msg, attachmentIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.messageRepo.Create(ctx, msg); err != nil {
+		if len(attachmentIDs) > 0 {
+			s.releaseAttachments(ctx, msg.ID)
+		}
+		return nil, err
+	}
+	s.recordHits(ctx, input.GroupID, input.SenderID, msg.ID, result)
+
+	s.increaseMessageCount(ctx, input.GroupID, 1)
+	if msg.ParentID != "" && !msg.IsShadowed() {
+		s.updateThread(ctx, msg.ParentID, 1, &now)
+	}
+
+	// the sender already has a shadowed message from the send reply, and
+	// nobody else may see it
+	if !msg.IsShadowed() {
+		s.publish(ctx, event.NewMessage(event.MessageCreated, &msg), jetstream.WithMsgID(msg.ID))
+		s.notifyMentions(ctx, &msg)
+	}
+	return &msg, nil
+}
+
+// validateText trims the text of a message, which may only be empty when
+// the message has attachments.
+func validateText(raw string, hasAttachments bool) (string, error) {
+	text := strings.TrimSpace(raw)
+	if text == "" && !hasAttachments {
+		return "", fmt.Errorf("%w: message must not be empty", ErrInvalidInput)
+	}
+	if utf8.RuneCountInString(text) > maxMessageLength {
+		return "", fmt.Errorf("%w: message exceeds %d characters", ErrInvalidInput, maxMessageLength)
+	}
+	return text, nil
+}
+
+// checkCanPost fails unless the group is open and memberID belongs to it.
+func (s *messageService) checkCanPost(ctx context.Context, groupID, memberID string) (*model.Group, error) {
+	group, err := s.groupRepo.FindOneByConditions(ctx, bson.M{"id": groupID}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if group.Status == model.GroupStatusLocked {
+		return nil, ErrGroupLocked
+	}
+
+	isMember, err := s.memberGroupRepo.IsMember(ctx, groupID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, ErrNotMember
+	}
+	return group, nil
+}
+
+// moderate applies the strongest action of the matched filters to text.
+// Only a block fails, the other actions let the message through, possibly
+// masked, with a moderation marker naming every matched filter.
+func moderate(text string, result *ngfilter.Result) (string, *model.MessageModeration, error) {
+	action := result.Action()
+	switch action {
+	case "":
+		return text, nil, nil
+	case model.NGFilterActionBlock:
+		return "", nil, &NGFilterError{Matches: result.MatchesWith(action)}
+	case model.NGFilterActionMask:
+		text = ngfilter.Mask(text, result.MatchesWith(action))
+	}
+
+	moderation := &model.MessageModeration{
+		Action:    action,
+		FilterIDs: make([]string, len(result.Matches)),
+	}
+	for i, m := range result.Matches {
+		moderation.FilterIDs[i] = m.FilterID
+	}
+	return text, moderation, nil
+}
+
+// findThread returns the top-level message of a thread in groupID, as far
+// as viewerID may see it.
+func (s *messageService) findThread(ctx context.Context, groupID, threadID, viewerID string) (*model.Message, error) {
+	root, err := s.messageRepo.FindOneByConditions(ctx, bson.M{"id": threadID}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if root.GroupID != groupID || (root.IsShadowed() && root.SenderID != viewerID) {
+		return nil, mongo.ErrNoDocuments
+	}
+	if root.ParentID != "" {
+		return nil, fmt.Errorf("%w: replies cannot be replied to", ErrInvalidInput)
+	}
+	return root, nil
+}
+
+// ListHistory pages through a group's messages by keyset. NextCursor keeps
+// going in the same direction, PrevCursor turns around from the first item.
+func (s *messageService) ListHistory(ctx context.Context, input ListHistoryInput) (*HistoryPage, error) {
+	params := repository.ListMessagesParams{
+		GroupID:        input.GroupID,
+		Direction:      input.Direction,
+		ViewerID:       input.ViewerID,
+		Flagged:        input.Flagged,
+		IncludeDeleted: input.IncludeDeleted,
+		ThreadID:       input.ThreadID,
+		// one extra row tells whether another page exists
+		Limit: input.Limit + 1,
+	}
+
+	if params.Direction == "" {
+		params.Direction = model.MsgSortDesc
+	}
+	if !params.Direction.IsValid() {
+		return nil, fmt.Errorf("%w: direction must be %q or %q", ErrInvalidInput, model.MsgSortAsc, model.MsgSortDesc)
+	}
+
+	var cursor *model.MessageCursor
+	if input.Cursor != "" {
+		var err error
+		cursor, err = model.DecodeMessageCursor(input.Cursor)
+		if err == nil && cursor.GroupID != input.GroupID {
+			err = model.ErrInvalidCursor
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		params.After = cursor
+		params.Direction = cursor.Direction
+		if cursor.Backward {
+			params.Direction = cursor.Direction.Reverse()
+		}
+	}
+
+	exist, err := s.groupRepo.CheckExist(ctx, input.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, mongo.ErrNoDocuments
+	}
+	if err := s.checkMember(ctx, input.GroupID, input.ViewerID); err != nil {
+		return nil, err
+	}
+
+	if input.ThreadID != "" {
+		if _, err := s.findThread(ctx, input.GroupID, input.ThreadID, input.ViewerID); err != nil {
+			return nil, err
+		}
+	}
+
+	messages, err := s.messageRepo.ListByGroup(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	more := int64(len(messages)) > input.Limit
+	if more {
+		messages = messages[:input.Limit]
+	}
+
+	// a backward page is read from its end but returned in the order of the
+	// other pages, and the page its cursor came from follows it
+	direction, hasNext, hasPrev := params.Direction, more, cursor != nil
+	if cursor != nil && cursor.Backward {
+		slices.Reverse(messages)
+		direction, hasNext, hasPrev = cursor.Direction, true, more
+	}
+
+	page := &HistoryPage{Messages: messages}
+	if len(messages) > 0 {
+		if hasNext {
+			page.NextCursor = model.NewMessageCursor(messages[len(messages)-1], direction, false).Encode()
+		}
+		if hasPrev {
+			page.PrevCursor = model.NewMessageCursor(messages[0], direction, true).Encode()
+		}
+	}
+	if page.Messages == nil {
+		page.Messages = []model.Message{}
+	}
+	return page, nil
+}
+
+// ListThreads pages through a group's threads, latest activity first.
+func (s *messageService) ListThreads(ctx context.Context, input ListThreadsInput) (*ThreadPage, error) {
+	params := repository.ListThreadsParams{
+		GroupID:  input.GroupID,
+		ViewerID: input.ViewerID,
+		// one extra row tells whether another page exists
+		Limit: input.Limit + 1,
+	}
+
+	if input.Cursor != "" {
+		cursor, err := model.DecodeThreadCursor(input.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		params.After = cursor
+	}
+
+	exist, err := s.groupRepo.CheckExist(ctx, input.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, mongo.ErrNoDocuments
+	}
+	if err := s.checkMember(ctx, input.GroupID, input.ViewerID); err != nil {
+		return nil, err
+	}
+
+	threads, err := s.messageRepo.ListThreads(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &ThreadPage{Threads: threads}
+	if int64(len(threads)) > input.Limit {
+		page.Threads = threads[:input.Limit]
+		page.NextCursor = model.NewThreadCursor(page.Threads[len(page.Threads)-1]).Encode()
+	}
+	if page.Threads == nil {
+		page.Threads = []model.Message{}
+	}
+	return page, nil
+}
+
+// Delete soft-deletes a message, which stays restorable until the purge
+// job removes it.
+func (s *messageService) Delete(ctx context.Context, input DeleteMessageInput) (*model.Message, error) {
+	if input.GroupID != "" || input.ActorID != "" {
+		msg, err := s.messageRepo.FindOneByConditions(ctx, bson.M{"id": input.ID}, nil)
+		if err != nil {
+			return nil, err
+		}
+		if input.GroupID != "" && msg.GroupID != input.GroupID {
+			return nil, mongo.ErrNoDocuments
+		}
+		if input.ActorID != "" && msg.SenderID != input.ActorID {
+			return nil, ErrNotSender
+		}
+	}
+
+	msg, err := s.messageRepo.DeleteByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.increaseMessageCount(ctx, msg.GroupID, -1)
+	if msg.ParentID != "" && !msg.IsShadowed() {
+		s.updateThread(ctx, msg.ParentID, -1, nil)
+	}
+	if !msg.IsShadowed() {
+		s.publish(ctx, event.NewMessage(event.MessageDeleted, msg))
+	}
+	return msg, nil
+}
+
+// Restore undoes Delete.
+func (s *messageService) Restore(ctx context.Context, ID string) (*model.Message, error) {
+	msg, err := s.messageRepo.RestoreByID(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.increaseMessageCount(ctx, msg.GroupID, 1)
+	if msg.ParentID != "" && !msg.IsShadowed() {
+		s.updateThread(ctx, msg.ParentID, 1, nil)
+	}
+	if !msg.IsShadowed() {
+		s.publish(ctx, event.NewMessage(event.MessageRestored, msg))
+	}
+	return msg, nil
+}
+
+// Edit replaces the text of a message, keeping the previous version as a
+// revision. The new text goes through the NG filters like a new message
+// would, so an edit can also mask, flag or shadow a message, or be blocked.
+// Concurrent edits of the same message fail with ErrEditConflict rather
+// than losing a revision, and deleting it meanwhile with
+// mongo.ErrNoDocuments.
+func (s *messageService) Edit(ctx context.Context, input EditMessageInput) (*model.Message, error) {
+	msg, err := s.messageRepo.FindOneByConditions(ctx, bson.M{"id": input.ID}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := validateText(input.Message, len(msg.Attachments) > 0)
+	if err != nil {
+		return nil, err
+	}
+	if input.GroupID != "" && msg.GroupID != input.GroupID {
+		return nil, mongo.ErrNoDocuments
+	}
+	if msg.SenderID != input.EditorID {
+		return nil, ErrNotSender
+	}
+
+	if _, err := s.checkCanPost(ctx, msg.GroupID, input.EditorID); err != nil {
+		return nil, err
+	}
+
+	result, err := s.ngFilterEngine.Evaluate(ctx, msg.GroupID, text)
+	if err != nil {
+		return nil, err
+	}
+	text, moderation, err := moderate(text, result)
+	if err != nil {
+		s.recordHits(ctx, msg.GroupID, msg.SenderID, "", result)
+		return nil, err
+	}
+
+	now := time.Now()
+	revision := model.MessageRevision{
+		BaseModel: model.BaseModel{
+			ID:        uuid.NewString(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		MessageID:  msg.ID,
+		GroupID:    msg.GroupID,
+		EditorID:   input.EditorID,
+		Message:    msg.Message,
+		Moderation: msg.Moderation,
+	}
+
+	// the revision goes first, so an edit is never applied without it
+	if err := s.messageRevisionRepo.Create(ctx, revision); err != nil {
+		return nil, err
+	}
+
+	set := bson.M{"message": text, "edited_at": now, "updated_at": now}
+	update := bson.M{"$set": set}
+	if moderation != nil {
+		set["moderation"] = moderation
+	} else {
+		update["$unset"] = bson.M{"moderation": ""}
+	}
+
+	// matching updated_at makes the edit fail if anything changed the
+	// message since it was read
+	filter := bson.M{"id": msg.ID, "updated_at": msg.UpdatedAt, "deleted_at": nil}
+	edited, err := s.messageRepo.UpdateOneByConditions(ctx, filter, update)
+	if err != nil {
+		if delErr := s.messageRevisionRepo.DeleteByID(ctx, revision.ID); delErr != nil {
+			s.logger.Warn(ctx, "failed to drop revision of failed edit", "message_id", msg.ID, "error", delErr)
+		}
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// a message deleted in the meantime is not found, like one
+			// deleted before the edit started
+			if _, findErr := s.messageRepo.FindOneByConditions(ctx, bson.M{"id": msg.ID}, nil); findErr != nil {
+				return nil, findErr
+			}
+			return nil, ErrEditConflict
+		}
+		return nil, err
+	}
+	s.recordHits(ctx, edited.GroupID, edited.SenderID, edited.ID, result)
+
+	// a shadowed reply does not count in its thread
+	if edited.ParentID != "" && edited.IsShadowed() != msg.IsShadowed() {
+		n := 1
+		if edited.IsShadowed() {
+			n = -1
+		}
+		s.updateThread(ctx, edited.ParentID, n, nil)
+	}
+
+	switch {
+	case !edited.IsShadowed():
+		s.publish(ctx, event.NewMessage(event.MessageEdited, edited))
+	case !msg.IsShadowed():
+		// the group saw the previous version, which has to go away now
+		s.publish(ctx, event.NewMessage(event.MessageDeleted, msg))
+	}
+	return edited, nil
+}
+
+// ListRevisions returns the previous versions of a message, oldest first.
+func (s *messageService) ListRevisions(
+	ctx context.Context,
+	input ListRevisionsInput,
+) ([]model.MessageRevision, error) {
+	msg, err := s.findReadable(ctx, input.ID, input.GroupID, input.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+	return s.messageRevisionRepo.ListByMessage(ctx, msg.ID)
+}
+
+// AddReaction lets a member react to a message. Reacting twice with the
+// same emoji changes nothing, and only actual changes are published.
+func (s *messageService) AddReaction(ctx context.Context, input ReactionInput) (*ReactionChange, error) {
+	return s.react(ctx, input, true)
+}
+
+// RemoveReaction takes back a reaction. Removing a reaction which is not
+// there changes nothing.
+func (s *messageService) RemoveReaction(ctx context.Context, input ReactionInput) (*ReactionChange, error) {
+	return s.react(ctx, input, false)
+}
+
+func (s *messageService) react(ctx context.Context, input ReactionInput, add bool) (*ReactionChange, error) {
+	emoji, err := s.validateEmoji(input.Emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := s.findVisible(ctx, input.MessageID, input.GroupID, input.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.checkCanPost(ctx, msg.GroupID, input.MemberID); err != nil {
+		return nil, err
+	}
+
+	var changed bool
+	if add {
+		now := time.Now()
+		changed, err = s.messageReactionRepo.Add(ctx, model.MessageReaction{
+			BaseModel: model.BaseModel{
+				ID:        uuid.NewString(),
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+			MessageID: msg.ID,
+			GroupID:   msg.GroupID,
+			MemberID:  input.MemberID,
+			Emoji:     emoji,
+		})
+	} else {
+		changed, err = s.messageReactionRepo.Remove(ctx, msg.ID, input.MemberID, emoji)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.messageReactionRepo.CountByEmoji(ctx, msg.ID, emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &ReactionChange{
+		MessageID: msg.ID,
+		MemberID:  input.MemberID,
+		Emoji:     emoji,
+		Count:     count,
+		Changed:   changed,
+	}
+	if changed && !msg.IsShadowed() {
+		t := event.ReactionRemoved
+		if add {
+			t = event.ReactionAdded
+		}
+		evt := event.New(t, msg.GroupID, change)
+		evt.ThreadID = msg.ParentID
+		s.publish(ctx, evt)
+	}
+	return change, nil
+}
+
+// ListReactions returns per-emoji counts of the reactions to a message,
+// with the members behind them.
+func (s *messageService) ListReactions(
+	ctx context.Context,
+	input ListReactionsInput,
+) ([]repository.ReactionSummary, error) {
+	msg, err := s.findReadable(ctx, input.ID, input.GroupID, input.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+	return s.messageReactionRepo.Summarize(ctx, msg.ID)
+}
+
+// MarkRead moves the read marker of a member forward to a top-level
+// message of the group. Marking an older message changes nothing, so
+// clients may mark whatever they display without ordering their calls.
+func (s *messageService) MarkRead(ctx context.Context, input MarkReadInput) (*model.MemberGroup, error) {
+	msg, err := s.findVisible(ctx, input.MessageID, input.GroupID, input.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.ParentID != "" {
+		return nil, fmt.Errorf("%w: replies have no read marker", ErrInvalidInput)
+	}
+
+	memberGroup, moved, err := s.memberGroupRepo.MarkRead(ctx, msg.GroupID, input.MemberID, msg.ID, msg.CreatedAt)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotMember
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if moved {
+		s.publish(ctx, event.New(event.MessageRead, msg.GroupID, ReadReceipt{
+			MemberID:  input.MemberID,
+			MessageID: msg.ID,
+			ReadAt:    memberGroup.UpdatedAt,
+		}))
+	}
+	return memberGroup, nil
+}
+
+// UnreadCounts returns the number of unread messages in every group of a
+// member, capped at maxUnreadCount.
+func (s *messageService) UnreadCounts(ctx context.Context, memberID string) ([]repository.UnreadCount, error) {
+	return s.memberGroupRepo.UnreadCounts(ctx, memberID, maxUnreadCount)
+}
+
+// ListReaders returns the members who have read a message.
+func (s *messageService) ListReaders(ctx context.Context, input ListReadersInput) ([]string, error) {
+	msg, err := s.findReadable(ctx, input.ID, input.GroupID, input.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.ParentID != "" {
+		return nil, fmt.Errorf("%w: replies have no read marker", ErrInvalidInput)
+	}
+	return s.memberGroupRepo.FindReaderIDs(ctx, msg.GroupID, msg.ID, msg.CreatedAt)
+}
+
+// SetMute silences, or unsilences, the notifications a member gets from a
+// group.
+func (s *messageService) SetMute(ctx context.Context, input SetMuteInput) (*model.MemberGroup, error) {
+	if input.Muted && input.Until != nil && !input.Until.After(time.Now()) {
+		return nil, fmt.Errorf("%w: mute must end in the future", ErrInvalidInput)
+	}
+
+	memberGroup, err := s.memberGroupRepo.SetMute(ctx, input.GroupID, input.MemberID, input.Muted, input.Until)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotMember
+	}
+	return memberGroup, err
+}
+
+// notifyMentions publishes the message to the subject of every mentioned
+// member who belongs to the group and has not muted it, so their clients
+// hear of it without following the group. Mentioning oneself notifies
+// nobody.
+func (s *messageService) notifyMentions(ctx context.Context, msg *model.Message) {
+	seen := make(map[string]struct{}, len(msg.Mentions))
+	mentioned := make([]string, 0, len(msg.Mentions))
+	for _, memberID := range msg.Mentions {
+		if _, ok := seen[memberID]; ok || memberID == msg.SenderID {
+			continue
+		}
+		seen[memberID] = struct{}{}
+		mentioned = append(mentioned, memberID)
+	}
+	if len(mentioned) == 0 {
+		return
+	}
+
+	memberIDs, err := s.memberGroupRepo.FindNotifiableIDs(ctx, msg.GroupID, mentioned)
+	if err != nil {
+		s.logger.Warn(ctx, "failed to resolve mentioned members", "message_id", msg.ID, "error", err)
+		return
+	}
+
+	evt := event.NewMessage(event.MemberMentioned, msg)
+	for _, memberID := range memberIDs {
+		s.publishTo(ctx, event.MemberSubject(memberID), evt, jetstream.WithMsgID(msg.ID+"."+memberID))
+	}
+}
+
+// findVisible finds a live message. groupID, when set, must be its group,
+// and viewerID, when set, must be allowed to see it.
+func (s *messageService) findVisible(ctx context.Context, ID, groupID, viewerID string) (*model.Message, error) {
+	msg, err := s.messageRepo.FindOneByConditions(ctx, bson.M{"id": ID}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if groupID != "" && msg.GroupID != groupID {
+		return nil, mongo.ErrNoDocuments
+	}
+	if viewerID != "" && msg.IsShadowed() && msg.SenderID != viewerID {
+		return nil, mongo.ErrNoDocuments
+	}
+	return msg, nil
+}
+
+// findReadable is findVisible for what only members of the group may see
+// about a message, such as its revisions, reactions and readers.
+func (s *messageService) findReadable(ctx context.Context, ID, groupID, viewerID string) (*model.Message, error) {
+	msg, err := s.findVisible(ctx, ID, groupID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkMember(ctx, msg.GroupID, viewerID); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// checkMember fails with ErrNotMember unless viewerID is a member of the
+// group. Without a viewer, the caller is trusted.
+func (s *messageService) checkMember(ctx context.Context, groupID, viewerID string) error {
+	if viewerID == "" {
+		return nil
+	}
+
+	isMember, err := s.memberGroupRepo.IsMember(ctx, groupID, viewerID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return ErrNotMember
+	}
+	return nil
+}
+
+// validateEmoji accepts the configured emojis when there are some, and
+// any single emoji otherwise.
+func (s *messageService) validateEmoji(raw string) (string, error) {
+	emoji := strings.TrimSpace(raw)
+	if emoji == "" {
+		return "", fmt.Errorf("%w: emoji must not be empty", ErrInvalidInput)
+	}
+	if len(s.allowedEmojis) > 0 {
+		if !slices.Contains(s.allowedEmojis, emoji) {
+			return "", fmt.Errorf("%w: emoji %q is not allowed", ErrInvalidInput, emoji)
+		}
+		return emoji, nil
+	}
+	if len(emoji) > maxEmojiLength {
+		return "", fmt.Errorf("%w: emoji exceeds %d bytes", ErrInvalidInput, maxEmojiLength)
+	}
+	if !isEmoji(emoji) {
+		return "", fmt.Errorf("%w: %q is not an emoji", ErrInvalidInput, emoji)
+	}
+	return emoji, nil
+}
+
+func (s *messageService) increaseMessageCount(ctx context.Context, groupID string, n int) {
+	inc := bson.M{"$inc": bson.M{"message_count": n}}
+	if _, err := s.groupRepo.UpdateByID(ctx, groupID, inc); err != nil {
+		s.logger.Warn(ctx, "failed to update message count", "group_id", groupID, "error", err)
+	}
+}
+
+// updateThread keeps the reply count of a thread in step with its replies
+// and tells the group about it. at moves the latest activity forward, if
+// later than the current one.
+func (s *messageService) updateThread(ctx context.Context, threadID string, n int, at *time.Time) {
+	update := bson.M{"$inc": bson.M{"reply_count": n}}
+	if at != nil {
+		update["$max"] = bson.M{"last_reply_at": *at}
+	}
+
+	root, err := s.messageRepo.UpdateByID(ctx, threadID, update)
+	if err != nil {
+		s.logger.Warn(ctx, "failed to update thread", "thread_id", threadID, "error", err)
+		return
+	}
+	if !root.IsShadowed() {
+		s.publish(ctx, event.New(event.ThreadUpdated, root.GroupID, root))
+	}
+}
+
+// recordHits keeps an audit trail of the filters a message matched.
+// Losing it is not worth failing the send over, so errors are only logged.
+func (s *messageService) recordHits(
+	ctx context.Context,
+	groupID string,
+	senderID string,
+	messageID string,
+	result *ngfilter.Result,
+) {
+	if !result.Matched() {
+		return
+	}
+
+	now := time.Now()
+	action := result.Action()
+	hits := make([]model.NGFilterHit, len(result.Matches))
+	for i, m := range result.Matches {
+		hits[i] = model.NGFilterHit{
+			BaseModel: model.BaseModel{
+				ID:        uuid.NewString(),
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+			FilterID:  m.FilterID,
+			GroupID:   groupID,
+			SenderID:  senderID,
+			MessageID: messageID,
+			Action:    action,
+		}
+	}
+
+	if err := s.ngFilterHitRepo.CreateBatch(ctx, hits); err != nil {
+		s.logger.Warn(ctx, "failed to record NG filter hits", "group_id", groupID, "error", err)
+	}
+}
+
+func (s *messageService) publish(ctx context.Context, evt event.Event, opts ...jetstream.PublishOpt) {
+	s.publishTo(ctx, evt.Subject(), evt, opts...)
+}
+
+func (s *messageService) publishTo(ctx context.Context, subject string, evt event.Event, opts ...jetstream.PublishOpt) {
+	if _, err := s.publisher.Publish(ctx, subject, evt, nil, opts...); err != nil {
+		s.logger.Warn(ctx, "failed to publish event", "type", evt.Type, "subject", subject, "error", err)
+	}
+}
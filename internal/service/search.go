@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/model"
+)
+
+const (
+	maxSearchQueryLength = 256
+	// snippetLength is the length, in runes, of the message excerpt around
+	// the first match; shorter messages are shown whole.
+	snippetLength  = 160
+	snippetLead    = 40
+	snippetEllipse = "…"
+)
+
+type SearchInput struct {
+	Query string
+	// GroupID, SenderID and Mention narrow the search when set.
+	GroupID  string
+	SenderID string
+	Mention  string
+	Priority *bool
+	From     *time.Time
+	To       *time.Time
+	// Sort only applies to the first page, a cursor carries its own.
+	Sort   model.SearchSort
+	Cursor string
+	Limit  int64
+	// ViewerID, when set, must be a member of the group, and also finds
+	// their own shadowed messages.
+	ViewerID string
+}
+
+// Highlight is a match within a snippet, in byte offsets.
+type Highlight struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+type SearchHit struct {
+	Message    model.Message `json:"message"`
+	Score      float64       `json:"score"`
+	Snippet    string        `json:"snippet"`
+	Highlights []Highlight   `json:"highlights"`
+}
+
+type SearchPage struct {
+	Hits       []SearchHit
+	NextCursor string
+}
+
+// Search finds messages through the text index, ranked by relevance or
+// recency, each with a snippet of the text around its first match.
+func (s *messageService) Search(ctx context.Context, input SearchInput) (*SearchPage, error) {
+	query := strings.TrimSpace(input.Query)
+	if query == "" {
+		return nil, fmt.Errorf("%w: query must not be empty", ErrInvalidInput)
+	}
+	if utf8.RuneCountInString(query) > maxSearchQueryLength {
+		return nil, fmt.Errorf("%w: query exceeds %d characters", ErrInvalidInput, maxSearchQueryLength)
+	}
+	if input.From != nil && input.To != nil && !input.From.Before(*input.To) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidInput)
+	}
+
+	params := repository.SearchMessagesParams{
+		Query:    query,
+		GroupID:  input.GroupID,
+		SenderID: input.SenderID,
+		Mention:  input.Mention,
+		Priority: input.Priority,
+		From:     input.From,
+		To:       input.To,
+		Sort:     input.Sort,
+		ViewerID: input.ViewerID,
+		// one extra row tells whether another page exists
+		Limit: input.Limit + 1,
+	}
+
+	if params.Sort == "" {
+		params.Sort = model.SearchSortRelevance
+	}
+	if !params.Sort.IsValid() {
+		return nil, fmt.Errorf("%w: sort must be %q or %q", ErrInvalidInput, model.SearchSortRelevance, model.SearchSortRecency)
+	}
+
+	if input.Cursor != "" {
+		cursor, err := model.DecodeSearchCursor(input.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		params.After = cursor
+		params.Sort = cursor.Sort
+	}
+
+	if err := s.checkMember(ctx, input.GroupID, input.ViewerID); err != nil {
+		return nil, err
+	}
+
+	results, err := s.messageRepo.Search(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &SearchPage{}
+	if int64(len(results)) > input.Limit {
+		results = results[:input.Limit]
+		last := results[len(results)-1]
+		page.NextCursor = model.SearchCursor{
+			Sort:      params.Sort,
+			Score:     last.Score,
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		}.Encode()
+	}
+
+	terms := searchTerms(query)
+	page.Hits = make([]SearchHit, len(results))
+	for i, r := range results {
+		snippet, highlights := makeSnippet(r.Message.Message, terms)
+		page.Hits[i] = SearchHit{
+			Message:    r.Message,
+			Score:      r.Score,
+			Snippet:    snippet,
+			Highlights: highlights,
+		}
+	}
+	return page, nil
+}
+
+// searchTerms returns a pattern matching the words and phrases of a text
+// search query, leaving out the excluded ones.
+func searchTerms(query string) *regexp.Regexp {
+	var terms []string
+	for i, part := range strings.Split(query, `"`) {
+		// odd parts are quoted phrases
+		if i%2 == 1 {
+			if phrase := strings.TrimSpace(part); phrase != "" {
+				terms = append(terms, regexp.QuoteMeta(phrase))
+			}
+			continue
+		}
+		for _, word := range strings.Fields(part) {
+			if !strings.HasPrefix(word, "-") {
+				terms = append(terms, regexp.QuoteMeta(word))
+			}
+		}
+	}
+	if len(terms) == 0 {
+		return nil
+	}
+	return regexp.MustCompile(`(?i)` + strings.Join(terms, "|"))
+}
+
+// makeSnippet cuts an excerpt of text around the first whole-word match of
+// terms, and locates every match within it.
+func makeSnippet(text string, terms *regexp.Regexp) (string, []Highlight) {
+	var matches [][]int
+	if terms != nil {
+		for _, loc := range terms.FindAllStringIndex(text, -1) {
+			if isWordEdge(text, loc[0], loc[1]) {
+				matches = append(matches, loc)
+			}
+		}
+	}
+
+	start, end := 0, len(text)
+	if utf8.RuneCountInString(text) > snippetLength {
+		if len(matches) > 0 {
+			start = backRunes(text, matches[0][0], snippetLead)
+		}
+		end = forwardRunes(text, start, snippetLength)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString(snippetEllipse)
+	}
+	offset := b.Len() - start
+	b.WriteString(text[start:end])
+	if end < len(text) {
+		b.WriteString(snippetEllipse)
+	}
+
+	highlights := []Highlight{}
+	for _, m := range matches {
+		if m[0] >= start && m[1] <= end {
+			highlights = append(highlights, Highlight{Start: m[0] + offset, End: m[1] + offset})
+		}
+	}
+	return b.String(), highlights
+}
+
+// isWordEdge reports whether text[start:end] is neither preceded nor
+// followed by a letter or digit.
+func isWordEdge(text string, start, end int) bool {
+	before, _ := utf8.DecodeLastRuneInString(text[:start])
+	after, _ := utf8.DecodeRuneInString(text[end:])
+	return !isWordRune(before) && !isWordRune(after)
+}
+
+func isWordRune(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+// backRunes steps n runes back from byte offset i.
+func backRunes(text string, i, n int) int {
+	for ; n > 0 && i > 0; n-- {
+		_, size := utf8.DecodeLastRuneInString(text[:i])
+		i -= size
+	}
+	return i
+}
+
+// forwardRunes steps n runes forward from byte offset i.
+func forwardRunes(text string, i, n int) int {
+	for ; n > 0 && i < len(text); n-- {
+		_, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+	}
+	return i
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSearchTerms(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"words", "hello world", `(?i)hello|world`},
+		{"phrase", `"exact phrase" other`, `(?i)exact phrase|other`},
+		{"excluded word", "-spam ham", `(?i)ham`},
+		{"excluded only", "-spam", ""},
+		{"excluded phrase is kept", `"-spam"`, `(?i)-spam`},
+		{"metacharacters", "a.b (c)", `(?i)a\.b|\(c\)`},
+		{"unterminated phrase", `say "hello there`, `(?i)say|hello there`},
+		{"empty phrase", `""`, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ""
+			if terms := searchTerms(tt.query); terms != nil {
+				got = terms.String()
+			}
+			if got != tt.want {
+				t.Errorf("searchTerms(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMakeSnippet(t *testing.T) {
+	long := strings.Repeat("a ", 100) + "needle" + strings.Repeat(" b", 100)
+	tests := []struct {
+		name     string
+		text     string
+		terms    string
+		want     string
+		wantHigh []Highlight
+	}{
+		{"whole text", "hello world", "world", "hello world", []Highlight{{6, 11}}},
+		{"case insensitive", "Hello World", "(?i)world", "Hello World", []Highlight{{6, 11}}},
+		{"whole words only", "worldwide world", "world", "worldwide world", []Highlight{{10, 15}}},
+		{"every match", "cat and cat", "cat", "cat and cat", []Highlight{{0, 3}, {8, 11}}},
+		{"multibyte", "猫 cat", "cat", "猫 cat", []Highlight{{4, 7}}},
+		{"no terms", "hello", "", "hello", []Highlight{}},
+		{"around the match", long, "needle", "…" + long[160:320] + "…", []Highlight{{43, 49}}},
+		{"without a match", long, "haystack", long[:160] + "…", []Highlight{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var terms *regexp.Regexp
+			if tt.terms != "" {
+				terms = regexp.MustCompile(tt.terms)
+			}
+
+			got, high := makeSnippet(tt.text, terms)
+			if got != tt.want || !slices.Equal(high, tt.wantHigh) {
+				t.Errorf("makeSnippet(%q, %q) = %q, %v, want %q, %v", tt.text, tt.terms, got, high, tt.want, tt.wantHigh)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package rest
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/noxhalley/funken/internal/auth"
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/service"
+)
+
+type AttachmentHandler struct {
+	authn      auth.Authenticator
+	messageSvc service.MessageService
+}
+
+func NewAttachmentHandler(
+	authn auth.Authenticator,
+	messageSvc service.MessageService,
+) *AttachmentHandler {
+	return &AttachmentHandler{
+		authn:      authn,
+		messageSvc: messageSvc,
+	}
+}
+
+// Register implements Handler.
+func (h *AttachmentHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("POST /groups/{id}/attachments", RequireMember(h.authn, h.upload))
+	mux.HandleFunc("GET /groups/{id}/attachments/{attachmentID}", RequireMember(h.authn, h.download))
+}
+
+// upload stores the request body as an attachment named after the name
+// query parameter. The attachment is sent by passing its ID along with a
+// message.
+func (h *AttachmentHandler) upload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	attachment, err := h.messageSvc.UploadAttachment(ctx, service.UploadAttachmentInput{
+		GroupID:     r.PathValue("id"),
+		UploaderID:  memberID,
+		Name:        r.URL.Query().Get("name"),
+		ContentType: r.Header.Get("Content-Type"),
+		Content:     r.Body,
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, attachment)
+}
+
+// download serves the content of an attachment. It is always served as a
+// download, so that a browser never renders it within the API origin.
+func (h *AttachmentHandler) download(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	attachment, content, err := h.messageSvc.OpenAttachment(ctx, service.OpenAttachmentInput{
+		ID:       r.PathValue("attachmentID"),
+		GroupID:  r.PathValue("id"),
+		ViewerID: memberID,
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	defer content.Close()
+
+	header := w.Header()
+	header.Set("Content-Type", attachment.MIMEType)
+	header.Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name}))
+	header.Set("X-Content-Type-Options", "nosniff")
+	header.Set("ETag", strconv.Quote(attachment.Checksum))
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := io.Copy(w, content); err != nil && ctx.Err() == nil {
+		log.Warn(ctx, "failed to send attachment", "attachment_id", attachment.ID, "error", err)
+	}
+}
@@ -0,0 +1,185 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+type GroupHandler struct {
+	groupRepo repository.GroupRepository
+}
+
+func NewGroupHandler(groupRepo repository.GroupRepository) *GroupHandler {
+	return &GroupHandler{groupRepo: groupRepo}
+}
+
+// Register implements Handler.
+func (h *GroupHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("POST /groups", h.create)
+	mux.HandleFunc("GET /groups", h.list)
+	mux.HandleFunc("GET /groups/{id}", h.get)
+	mux.HandleFunc("PUT /groups/{id}", h.update)
+	mux.HandleFunc("DELETE /groups/{id}", h.delete)
+}
+
+func (h *GroupHandler) create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	group := model.Group{}
+	if err := decodeJSON(w, r, &group); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	if group.ID == "" {
+		group.ID = uuid.NewString()
+	}
+	if !model.IsValidGroupID(group.ID) {
+		writeError(ctx, w, badRequest("group id may only hold letters, digits, '_' and '-'"))
+		return
+	}
+	if group.Status == 0 {
+		group.Status = model.GroupStatusActive
+	}
+	if !group.Status.IsValid() {
+		writeError(ctx, w, badRequest("invalid group status %d", group.Status))
+		return
+	}
+	if group.Retention != nil && !group.Retention.IsValid() {
+		writeError(ctx, w, badRequest("invalid retention"))
+		return
+	}
+	if group.AttachmentPolicy != nil && !group.AttachmentPolicy.IsValid() {
+		writeError(ctx, w, badRequest("invalid attachment policy"))
+		return
+	}
+
+	now := time.Now()
+	group.CreatedAt = now
+	group.UpdatedAt = now
+	group.MessageCount = 0
+	group.MemberCount = nil
+
+	if err := h.groupRepo.Create(ctx, group); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, group)
+}
+
+func (h *GroupHandler) get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	group, err := h.groupRepo.FindOneByConditions(ctx, bson.M{"id": r.PathValue("id")}, nil)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (h *GroupHandler) list(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit, err := queryInt(r, "limit", defaultPageSize, 1, maxPageSize)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	offset, err := queryInt(r, "offset", 0, 0, 1<<31)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	filter := bson.M{}
+	if r.URL.Query().Has("status") {
+		status, err := queryInt(r, "status", 0, 0, 127)
+		if err != nil || !model.GroupStatus(status).IsValid() {
+			writeError(ctx, w, badRequest("invalid group status"))
+			return
+		}
+		filter["status"] = model.GroupStatus(status)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(offset).
+		SetLimit(limit)
+
+	groups, err := h.groupRepo.FindByConditions(ctx, filter, opts)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	if groups == nil {
+		groups = []model.Group{}
+	}
+	writeJSON(w, http.StatusOK, listResponse[model.Group]{Data: groups})
+}
+
+func (h *GroupHandler) update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	input := model.Group{}
+	if err := decodeJSON(w, r, &input); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	set := bson.M{"updated_at": time.Now()}
+	if input.Meta != nil {
+		set["meta"] = input.Meta
+	}
+	if input.Status != 0 {
+		if !input.Status.IsValid() {
+			writeError(ctx, w, badRequest("invalid group status %d", input.Status))
+			return
+		}
+		set["status"] = input.Status
+	}
+	if input.Retention != nil {
+		if !input.Retention.IsValid() {
+			writeError(ctx, w, badRequest("invalid retention"))
+			return
+		}
+		set["retention"] = input.Retention
+	}
+	if input.AttachmentPolicy != nil {
+		if !input.AttachmentPolicy.IsValid() {
+			writeError(ctx, w, badRequest("invalid attachment policy"))
+			return
+		}
+		set["attachment_policy"] = input.AttachmentPolicy
+	}
+
+	group, err := h.groupRepo.UpdateByID(ctx, r.PathValue("id"), bson.M{"$set": set})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (h *GroupHandler) delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := h.groupRepo.DeleteByID(ctx, r.PathValue("id")); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
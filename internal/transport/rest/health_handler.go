@@ -0,0 +1,35 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/noxhalley/funken/internal/health"
+)
+
+type HealthHandler struct {
+	checker *health.Checker
+}
+
+func NewHealthHandler(checker *health.Checker) *HealthHandler {
+	return &HealthHandler{checker: checker}
+}
+
+// Register implements Handler.
+func (h *HealthHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /healthz", h.liveness)
+	mux.HandleFunc("GET /readyz", h.readiness)
+}
+
+func (h *HealthHandler) liveness(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.checker.Liveness())
+}
+
+func (h *HealthHandler) readiness(w http.ResponseWriter, r *http.Request) {
+	report := h.checker.Readiness(r.Context())
+
+	status := http.StatusOK
+	if report.Status != health.StatusUp {
+		status = http.StatusServiceUnavailable
+	}
+	writeJSON(w, status, report)
+}
@@ -0,0 +1,428 @@
+package rest
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/noxhalley/funken/internal/auth"
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/model"
+	"github.com/noxhalley/funken/internal/service"
+)
+
+const (
+	defaultMessagePageSize = 50
+	maxMessagePageSize     = 100
+)
+
+type messagePage struct {
+	Data       []model.Message `json:"data"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+	PrevCursor string          `json:"prev_cursor,omitempty"`
+}
+
+type sendMessageRequest struct {
+	Message       string   `json:"message"`
+	Mentions      []string `json:"mentions"`
+	Priority      bool     `json:"priority"`
+	Nickname      string   `json:"nickname"`
+	ParentID      string   `json:"parent_id"`
+	AttachmentIDs []string `json:"attachment_ids"`
+}
+
+type searchPage struct {
+	Data       []service.SearchHit `json:"data"`
+	NextCursor string              `json:"next_cursor,omitempty"`
+}
+
+type markReadRequest struct {
+	MessageID string `json:"message_id"`
+}
+
+type setMuteRequest struct {
+	Muted bool       `json:"muted"`
+	Until *time.Time `json:"until"`
+}
+
+type threadPage struct {
+	Data       []model.Message `json:"data"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
+
+type editMessageRequest struct {
+	Message string `json:"message"`
+}
+
+type MessageHandler struct {
+	authn      auth.Authenticator
+	messageSvc service.MessageService
+}
+
+func NewMessageHandler(
+	authn auth.Authenticator,
+	messageSvc service.MessageService,
+) *MessageHandler {
+	return &MessageHandler{
+		authn:      authn,
+		messageSvc: messageSvc,
+	}
+}
+
+// Register implements Handler.
+func (h *MessageHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /groups/{id}/messages", RequireMember(h.authn, h.list))
+	mux.HandleFunc("POST /groups/{id}/messages", RequireMember(h.authn, h.send))
+	mux.HandleFunc("GET /groups/{id}/search", RequireMember(h.authn, h.search))
+	mux.HandleFunc("GET /groups/{id}/threads", RequireMember(h.authn, h.listThreads))
+	mux.HandleFunc("GET /groups/{id}/threads/{threadID}/messages", RequireMember(h.authn, h.list))
+	mux.HandleFunc("DELETE /groups/{id}/messages/{messageID}", RequireMember(h.authn, h.delete))
+	mux.HandleFunc("PATCH /groups/{id}/messages/{messageID}", RequireMember(h.authn, h.edit))
+	mux.HandleFunc("GET /groups/{id}/messages/{messageID}/revisions", RequireMember(h.authn, h.listRevisions))
+	mux.HandleFunc("GET /groups/{id}/messages/{messageID}/readers", RequireMember(h.authn, h.listReaders))
+	mux.HandleFunc("PUT /groups/{id}/read", RequireMember(h.authn, h.markRead))
+	mux.HandleFunc("PUT /groups/{id}/mute", RequireMember(h.authn, h.setMute))
+	mux.HandleFunc("GET /members/me/unread", RequireMember(h.authn, h.unreadCounts))
+	mux.HandleFunc("GET /groups/{id}/messages/{messageID}/reactions", RequireMember(h.authn, h.listReactions))
+	mux.HandleFunc("PUT /groups/{id}/messages/{messageID}/reactions/{emoji}", RequireMember(h.authn, h.addReaction))
+	mux.HandleFunc("DELETE /groups/{id}/messages/{messageID}/reactions/{emoji}", RequireMember(h.authn, h.removeReaction))
+}
+
+func (h *MessageHandler) send(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	req := sendMessageRequest{}
+	if err := decodeJSON(w, r, &req); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	msg, err := h.messageSvc.Send(ctx, service.SendMessageInput{
+		GroupID:       r.PathValue("id"),
+		SenderID:      memberID,
+		Message:       req.Message,
+		Mentions:      req.Mentions,
+		Priority:      req.Priority,
+		Nickname:      req.Nickname,
+		IPAddress:     ClientIP(r),
+		ParentID:      req.ParentID,
+		AttachmentIDs: req.AttachmentIDs,
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, msg)
+}
+
+// delete lets a member take back their own message.
+func (h *MessageHandler) delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	_, err := h.messageSvc.Delete(ctx, service.DeleteMessageInput{
+		ID:      r.PathValue("messageID"),
+		GroupID: r.PathValue("id"),
+		ActorID: memberID,
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// edit lets a member change the text of their own message.
+func (h *MessageHandler) edit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	req := editMessageRequest{}
+	if err := decodeJSON(w, r, &req); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	msg, err := h.messageSvc.Edit(ctx, service.EditMessageInput{
+		ID:       r.PathValue("messageID"),
+		GroupID:  r.PathValue("id"),
+		EditorID: memberID,
+		Message:  req.Message,
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, msg)
+}
+
+func (h *MessageHandler) listRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	revisions, err := h.messageSvc.ListRevisions(ctx, service.ListRevisionsInput{
+		ID:       r.PathValue("messageID"),
+		GroupID:  r.PathValue("id"),
+		ViewerID: memberID,
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse[model.MessageRevision]{Data: revisions})
+}
+
+// markRead moves the caller's read marker in the group forward.
+func (h *MessageHandler) markRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	req := markReadRequest{}
+	if err := decodeJSON(w, r, &req); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	memberGroup, err := h.messageSvc.MarkRead(ctx, service.MarkReadInput{
+		GroupID:   r.PathValue("id"),
+		MemberID:  memberID,
+		MessageID: req.MessageID,
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, memberGroup)
+}
+
+// setMute silences, or unsilences, the caller's notifications from the
+// group.
+func (h *MessageHandler) setMute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	req := setMuteRequest{}
+	if err := decodeJSON(w, r, &req); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	memberGroup, err := h.messageSvc.SetMute(ctx, service.SetMuteInput{
+		GroupID:  r.PathValue("id"),
+		MemberID: memberID,
+		Muted:    req.Muted,
+		Until:    req.Until,
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, memberGroup)
+}
+
+// unreadCounts returns the caller's unread counts across all their groups.
+func (h *MessageHandler) unreadCounts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	counts, err := h.messageSvc.UnreadCounts(ctx, memberID)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse[repository.UnreadCount]{Data: counts})
+}
+
+func (h *MessageHandler) listReaders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	readers, err := h.messageSvc.ListReaders(ctx, service.ListReadersInput{
+		ID:       r.PathValue("messageID"),
+		GroupID:  r.PathValue("id"),
+		ViewerID: memberID,
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse[string]{Data: readers})
+}
+
+func (h *MessageHandler) listReactions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	reactions, err := h.messageSvc.ListReactions(ctx, service.ListReactionsInput{
+		ID:       r.PathValue("messageID"),
+		GroupID:  r.PathValue("id"),
+		ViewerID: memberID,
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, listResponse[repository.ReactionSummary]{Data: reactions})
+}
+
+// addReaction is idempotent, as PUT should be.
+func (h *MessageHandler) addReaction(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	change, err := h.messageSvc.AddReaction(ctx, reactionInput(r, memberID))
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, change)
+}
+
+func (h *MessageHandler) removeReaction(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	change, err := h.messageSvc.RemoveReaction(ctx, reactionInput(r, memberID))
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, change)
+}
+
+func reactionInput(r *http.Request, memberID string) service.ReactionInput {
+	return service.ReactionInput{
+		MessageID: r.PathValue("messageID"),
+		GroupID:   r.PathValue("id"),
+		MemberID:  memberID,
+		Emoji:     r.PathValue("emoji"),
+	}
+}
+
+// ClientIP returns the remote host of the request without its port.
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// list pages through a group's history, or a thread's replies when the
+// path names one. A cursor carries its own direction, so the direction
+// query parameter only applies to the first page. An authenticated caller
+// also sees their own shadowed messages. The queue of flagged messages is
+// left to moderators, through the gRPC API.
+func (h *MessageHandler) list(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	limit, err := queryInt(r, "limit", defaultMessagePageSize, 1, maxMessagePageSize)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	page, err := h.messageSvc.ListHistory(ctx, service.ListHistoryInput{
+		GroupID:   r.PathValue("id"),
+		Direction: model.MsgSortDirection(r.URL.Query().Get("direction")),
+		Cursor:    r.URL.Query().Get("cursor"),
+		Limit:     limit,
+		ViewerID:  memberID,
+		ThreadID:  r.PathValue("threadID"),
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, messagePage{
+		Data:       page.Messages,
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+	})
+}
+
+// listThreads pages through a group's threads, latest activity first.
+func (h *MessageHandler) listThreads(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	limit, err := queryInt(r, "limit", defaultMessagePageSize, 1, maxMessagePageSize)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	page, err := h.messageSvc.ListThreads(ctx, service.ListThreadsInput{
+		GroupID:  r.PathValue("id"),
+		Cursor:   r.URL.Query().Get("cursor"),
+		Limit:    limit,
+		ViewerID: memberID,
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, threadPage{
+		Data:       page.Threads,
+		NextCursor: page.NextCursor,
+	})
+}
+
+// search finds messages of the group matching the q query parameter. A
+// cursor carries its own ranking, so sort only applies to the first page.
+func (h *MessageHandler) search(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+	query := r.URL.Query()
+
+	limit, err := queryInt(r, "limit", defaultMessagePageSize, 1, maxMessagePageSize)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	priority, err := queryOptionalBool(r, "priority")
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	from, err := queryTime(r, "from")
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	to, err := queryTime(r, "to")
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	page, err := h.messageSvc.Search(ctx, service.SearchInput{
+		Query:    query.Get("q"),
+		GroupID:  r.PathValue("id"),
+		SenderID: query.Get("sender_id"),
+		Mention:  query.Get("mention"),
+		Priority: priority,
+		From:     from,
+		To:       to,
+		Sort:     model.SearchSort(query.Get("sort")),
+		Cursor:   query.Get("cursor"),
+		Limit:    limit,
+		ViewerID: memberID,
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, searchPage{
+		Data:       page.Hits,
+		NextCursor: page.NextCursor,
+	})
+}
@@ -0,0 +1,96 @@
+package rest
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/noxhalley/funken/internal/auth"
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// Hijack is needed by WebSocket upgraders which assert http.Hijacker directly.
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	r.status = http.StatusSwitchingProtocols
+	return http.NewResponseController(r.ResponseWriter).Hijack()
+}
+
+func withRequestContext(next http.Handler) http.Handler {
+	logger := log.With("service", "http_server")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqID := r.Header.Get(requestIDHeader)
+		if reqID == "" {
+			reqID = uuid.NewString()
+		}
+		w.Header().Set(requestIDHeader, reqID)
+
+		ctx := log.AddLogValToCtx(r.Context(), "request_id", reqID)
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+
+		next.ServeHTTP(rec, r.WithContext(ctx))
+
+		logger.Debug(ctx, "HTTP request handled",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", rec.status,
+			"latency", time.Since(start),
+		)
+	})
+}
+
+func recoverPanic(logger *log.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if v := recover(); v != nil {
+				if v == http.ErrAbortHandler {
+					panic(v)
+				}
+				logger.Error(r.Context(), "panic while handling request", "panic", v, "stack", string(debug.Stack()))
+				writeJSON(w, http.StatusInternalServerError, errorResponse{Error: http.StatusText(http.StatusInternalServerError)})
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// RequireMember rejects requests without a valid member token and stores
+// the member ID in the request context.
+func RequireMember(authn auth.Authenticator, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		memberID, err := authn.Verify(auth.TokenFromRequest(r))
+		if err != nil {
+			if !errors.Is(err, auth.ErrTokenExpired) {
+				err = auth.ErrUnauthenticated
+			}
+			writeJSON(w, http.StatusUnauthorized, errorResponse{Error: err.Error()})
+			return
+		}
+
+		ctx := auth.WithMemberID(r.Context(), memberID)
+		ctx = log.AddLogValToCtx(ctx, "member_id", memberID)
+		next(w, r.WithContext(ctx))
+	}
+}
@@ -0,0 +1,139 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/ngfilter"
+	"github.com/noxhalley/funken/internal/service"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const maxBodyBytes = 1 << 20 // 1 MB
+
+type errorResponse struct {
+	Error   string `json:"error"`
+	Details any    `json:"details,omitempty"`
+}
+
+type listResponse[T any] struct {
+	Data []T `json:"data"`
+}
+
+type badRequestError struct {
+	msg string
+}
+
+func (e *badRequestError) Error() string {
+	return e.msg
+}
+
+func badRequest(format string, args ...any) error {
+	return &badRequestError{msg: fmt.Sprintf(format, args...)}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError maps service, repository and request errors to HTTP status codes.
+func writeError(ctx context.Context, w http.ResponseWriter, err error) {
+	var (
+		badReq      *badRequestError
+		ngFilterErr *service.NGFilterError
+	)
+
+	switch {
+	case errors.As(err, &badReq):
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: badReq.msg})
+	case errors.Is(err, service.ErrInvalidInput),
+		errors.Is(err, ngfilter.ErrInvalidFilter):
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+	case errors.As(err, &ngFilterErr):
+		writeJSON(w, http.StatusUnprocessableEntity, errorResponse{
+			Error:   service.ErrNGFilterMatched.Error(),
+			Details: ngFilterErr.Matches,
+		})
+	case errors.Is(err, service.ErrNotMember),
+		errors.Is(err, service.ErrNotSender):
+		writeJSON(w, http.StatusForbidden, errorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrAttachmentTooLarge):
+		writeJSON(w, http.StatusRequestEntityTooLarge, errorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrGroupLocked),
+		errors.Is(err, service.ErrEditConflict):
+		writeJSON(w, http.StatusConflict, errorResponse{Error: err.Error()})
+	case errors.Is(err, mongo.ErrNoDocuments):
+		writeJSON(w, http.StatusNotFound, errorResponse{Error: "resource not found"})
+	case mongo.IsDuplicateKeyError(err):
+		writeJSON(w, http.StatusConflict, errorResponse{Error: "resource already exists"})
+	case errors.Is(err, context.Canceled):
+		// client went away, nothing left to write to
+	default:
+		log.Error(ctx, "failed to handle request", "error", err)
+		writeJSON(w, http.StatusInternalServerError, errorResponse{Error: http.StatusText(http.StatusInternalServerError)})
+	}
+}
+
+func decodeJSON(w http.ResponseWriter, r *http.Request, dst any) error {
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		return badRequest("invalid request body: %v", err)
+	}
+	return nil
+}
+
+func queryInt(r *http.Request, key string, def, min, max int64) (int64, error) {
+	raw := r.URL.Query().Get(key)
+	if raw == "" {
+		return def, nil
+	}
+
+	v, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || v < min || v > max {
+		return 0, badRequest("%s must be an integer between %d and %d", key, min, max)
+	}
+	return v, nil
+}
+
+// queryOptionalBool tells an absent parameter apart from false.
+func queryOptionalBool(r *http.Request, key string) (*bool, error) {
+	if r.URL.Query().Get(key) == "" {
+		return nil, nil
+	}
+	v, err := queryBool(r, key)
+	return &v, err
+}
+
+func queryTime(r *http.Request, key string) (*time.Time, error) {
+	raw := r.URL.Query().Get(key)
+	if raw == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, badRequest("%s must be an RFC 3339 timestamp", key)
+	}
+	return &t, nil
+}
+
+func queryBool(r *http.Request, key string) (bool, error) {
+	raw := r.URL.Query().Get(key)
+	if raw == "" {
+		return false, nil
+	}
+
+	v, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, badRequest("%s must be a boolean", key)
+	}
+	return v, nil
+}
@@ -0,0 +1,81 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/noxhalley/funken/config"
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+)
+
+// Handler registers a set of routes on the server's mux.
+type Handler interface {
+	Register(mux *http.ServeMux)
+}
+
+// Streamer is implemented by handlers serving long-lived streams. Shutdown
+// waits for requests to finish, so the server asks them to end their
+// streams first.
+type Streamer interface {
+	CloseStreams()
+}
+
+type Server struct {
+	logger *log.Logger
+	srv    *http.Server
+}
+
+func NewServer(cfg *config.Config, handlers []Handler) *Server {
+	logger := log.With("service", "http_server")
+
+	mux := http.NewServeMux()
+	for _, h := range handlers {
+		h.Register(mux)
+	}
+
+	srv := &http.Server{
+		Addr:              net.JoinHostPort("", cfg.App.Port),
+		Handler:           recoverPanic(logger, withRequestContext(mux)),
+		ReadHeaderTimeout: time.Duration(cfg.App.ReadHeaderTimeout) * time.Millisecond,
+		ReadTimeout:       time.Duration(cfg.App.ReadTimeout) * time.Millisecond,
+		WriteTimeout:      time.Duration(cfg.App.WriteTimeout) * time.Millisecond,
+		IdleTimeout:       time.Duration(cfg.App.IdleTimeout) * time.Millisecond,
+	}
+	for _, h := range handlers {
+		if st, ok := h.(Streamer); ok {
+			srv.RegisterOnShutdown(st.CloseStreams)
+		}
+	}
+
+	return &Server{
+		logger: logger,
+		srv:    srv,
+	}
+}
+
+// Start binds the listener synchronously so that a busy port fails the
+// fx start hook, then serves in the background.
+func (s *Server) Start(ctx context.Context) error {
+	ln, err := net.Listen("tcp", s.srv.Addr)
+	if err != nil {
+		s.logger.Error(ctx, "failed to listen", "addr", s.srv.Addr, "error", err)
+		return err
+	}
+
+	s.logger.Info(ctx, "HTTP server listening", "addr", ln.Addr().String())
+	go func() {
+		if err := s.srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error(context.Background(), "HTTP server stopped unexpectedly", "error", err)
+		}
+	}()
+	return nil
+}
+
+// Stop waits for in-flight requests to finish or for ctx to expire.
+func (s *Server) Stop(ctx context.Context) error {
+	s.logger.Info(ctx, "Shutting down HTTP server")
+	return s.srv.Shutdown(ctx)
+}
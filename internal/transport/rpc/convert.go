@@ -0,0 +1,307 @@
+package rpc
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/model"
+	"github.com/noxhalley/funken/internal/service"
+	"github.com/noxhalley/funken/pkg/pb"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// toStruct goes through JSON so that values decoded from Mongo which
+// structpb does not know about still come out in their JSON form.
+func toStruct(v interface{}) *structpb.Struct {
+	if v == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+
+	s := &structpb.Struct{}
+	if err := s.UnmarshalJSON(data); err != nil {
+		return nil
+	}
+	return s
+}
+
+func fromStruct(s *structpb.Struct) bson.M {
+	if s == nil {
+		return nil
+	}
+	return bson.M(s.AsMap())
+}
+
+func toPBGroup(g *model.Group) *pb.Group {
+	group := &pb.Group{
+		Id:              g.ID,
+		CreatedAt:       toTimestamp(g.CreatedAt),
+		UpdatedAt:       toTimestamp(g.UpdatedAt),
+		Meta:            toStruct(g.Meta),
+		Status:          pb.GroupStatus(g.Status),
+		MessageCount:    int64(g.MessageCount),
+		NgFilterOptOuts: g.NGFilterOptOuts,
+	}
+	if g.MemberCount != nil {
+		count := int64(*g.MemberCount)
+		group.MemberCount = &count
+	}
+	if g.Retention != nil {
+		group.Retention = toPBRetention(g.Retention)
+	}
+	if g.AttachmentPolicy != nil {
+		group.AttachmentPolicy = &pb.AttachmentPolicy{
+			MaxSize:      g.AttachmentPolicy.MaxSize,
+			AllowedTypes: g.AttachmentPolicy.AllowedTypes,
+		}
+	}
+	return group
+}
+
+var retentionKinds = map[model.RetentionKind]pb.RetentionKind{
+	model.RetentionForever:  pb.RetentionKind_RETENTION_KIND_FOREVER,
+	model.RetentionDays:     pb.RetentionKind_RETENTION_KIND_DAYS,
+	model.RetentionMessages: pb.RetentionKind_RETENTION_KIND_MESSAGES,
+}
+
+func toPBRetention(r *model.Retention) *pb.Retention {
+	return &pb.Retention{
+		Kind:  retentionKinds[r.Kind],
+		Value: int64(r.Value),
+	}
+}
+
+func fromPBAttachmentPolicy(p *pb.AttachmentPolicy) *model.AttachmentPolicy {
+	return &model.AttachmentPolicy{
+		MaxSize:      p.GetMaxSize(),
+		AllowedTypes: p.GetAllowedTypes(),
+	}
+}
+
+// fromPBRetention leaves the kind empty for unknown kinds, which makes the
+// retention invalid.
+func fromPBRetention(r *pb.Retention) *model.Retention {
+	retention := &model.Retention{Value: int(r.GetValue())}
+	for kind, pbKind := range retentionKinds {
+		if pbKind == r.GetKind() {
+			retention.Kind = kind
+		}
+	}
+	return retention
+}
+
+func toPBMessage(m *model.Message) *pb.Message {
+	msg := &pb.Message{
+		Id:         m.ID,
+		CreatedAt:  toTimestamp(m.CreatedAt),
+		UpdatedAt:  toTimestamp(m.UpdatedAt),
+		Message:    m.Message,
+		GroupId:    m.GroupID,
+		SenderId:   m.SenderID,
+		Mentions:   m.Mentions,
+		Priority:   m.Priority,
+		Nickname:   m.Nickname,
+		ParentId:   m.ParentID,
+		ReplyCount: m.ReplyCount,
+	}
+	if m.DeletedAt != nil {
+		msg.DeletedAt = toTimestamp(*m.DeletedAt)
+	}
+	if m.EditedAt != nil {
+		msg.EditedAt = toTimestamp(*m.EditedAt)
+	}
+	if m.LastReplyAt != nil {
+		msg.LastReplyAt = toTimestamp(*m.LastReplyAt)
+	}
+	msg.Moderation = toPBModeration(m.Moderation)
+	msg.Attachments = toPBAttachments(m.Attachments)
+	return msg
+}
+
+func toPBAttachments(attachments []model.Attachment) []*pb.Attachment {
+	res := make([]*pb.Attachment, len(attachments))
+	for i, a := range attachments {
+		res[i] = &pb.Attachment{
+			Id:         a.ID,
+			CreatedAt:  toTimestamp(a.CreatedAt),
+			GroupId:    a.GroupID,
+			UploaderId: a.UploaderID,
+			MessageId:  a.MessageID,
+			Name:       a.Name,
+			Size:       a.Size,
+			MimeType:   a.MIMEType,
+			Checksum:   a.Checksum,
+		}
+	}
+	return res
+}
+
+func toPBModeration(m *model.MessageModeration) *pb.MessageModeration {
+	if m == nil {
+		return nil
+	}
+	return &pb.MessageModeration{
+		Action:    toPBNGFilterAction(m.Action),
+		FilterIds: m.FilterIDs,
+	}
+}
+
+func toPBMessageRevisions(revisions []model.MessageRevision) []*pb.MessageRevision {
+	res := make([]*pb.MessageRevision, len(revisions))
+	for i, r := range revisions {
+		res[i] = &pb.MessageRevision{
+			Id:         r.ID,
+			CreatedAt:  toTimestamp(r.CreatedAt),
+			MessageId:  r.MessageID,
+			GroupId:    r.GroupID,
+			EditorId:   r.EditorID,
+			Message:    r.Message,
+			Moderation: toPBModeration(r.Moderation),
+		}
+	}
+	return res
+}
+
+func toPBMessages(messages []model.Message) []*pb.Message {
+	res := make([]*pb.Message, len(messages))
+	for i := range messages {
+		res[i] = toPBMessage(&messages[i])
+	}
+	return res
+}
+
+func toPBNGFilter(f *model.GroupNGFilter) *pb.GroupNGFilter {
+	return &pb.GroupNGFilter{
+		Id:        f.ID,
+		CreatedAt: toTimestamp(f.CreatedAt),
+		UpdatedAt: toTimestamp(f.UpdatedAt),
+		GroupId:   f.GroupID,
+		Title:     f.Title,
+		Pattern:   f.Pattern,
+		Flags:     f.Flags,
+		Action:    toPBNGFilterAction(f.EffectiveAction()),
+	}
+}
+
+func toPBNGFilters(filters []model.GroupNGFilter) []*pb.GroupNGFilter {
+	res := make([]*pb.GroupNGFilter, len(filters))
+	for i := range filters {
+		res[i] = toPBNGFilter(&filters[i])
+	}
+	return res
+}
+
+func toPBFilterHits(hits []model.NGFilterHit) []*pb.FilterHit {
+	res := make([]*pb.FilterHit, len(hits))
+	for i, h := range hits {
+		res[i] = &pb.FilterHit{
+			Id:        h.ID,
+			CreatedAt: toTimestamp(h.CreatedAt),
+			FilterId:  h.FilterID,
+			GroupId:   h.GroupID,
+			SenderId:  h.SenderID,
+			MessageId: h.MessageID,
+			Action:    toPBNGFilterAction(h.Action),
+		}
+	}
+	return res
+}
+
+func toSortDirection(d pb.SortDirection) model.MsgSortDirection {
+	switch d {
+	case pb.SortDirection_SORT_DIRECTION_ASC:
+		return model.MsgSortAsc
+	case pb.SortDirection_SORT_DIRECTION_DESC:
+		return model.MsgSortDesc
+	default:
+		return ""
+	}
+}
+
+var ngFilterActions = map[pb.NGFilterAction]model.NGFilterAction{
+	pb.NGFilterAction_NG_FILTER_ACTION_FLAG:   model.NGFilterActionFlag,
+	pb.NGFilterAction_NG_FILTER_ACTION_MASK:   model.NGFilterActionMask,
+	pb.NGFilterAction_NG_FILTER_ACTION_SHADOW: model.NGFilterActionShadow,
+	pb.NGFilterAction_NG_FILTER_ACTION_BLOCK:  model.NGFilterActionBlock,
+}
+
+func toSearchSort(s pb.SearchSort) model.SearchSort {
+	switch s {
+	case pb.SearchSort_SEARCH_SORT_RELEVANCE:
+		return model.SearchSortRelevance
+	case pb.SearchSort_SEARCH_SORT_RECENCY:
+		return model.SearchSortRecency
+	default:
+		return ""
+	}
+}
+
+func toPBSearchHit(h *service.SearchHit) *pb.SearchHit {
+	hit := &pb.SearchHit{
+		Message:    toPBMessage(&h.Message),
+		Score:      h.Score,
+		Snippet:    h.Snippet,
+		Highlights: make([]*pb.Highlight, len(h.Highlights)),
+	}
+	for i, hl := range h.Highlights {
+		hit.Highlights[i] = &pb.Highlight{Start: int32(hl.Start), End: int32(hl.End)}
+	}
+	return hit
+}
+
+func toNGFilterAction(a pb.NGFilterAction) model.NGFilterAction {
+	return ngFilterActions[a]
+}
+
+func toPBNGFilterAction(a model.NGFilterAction) pb.NGFilterAction {
+	for k, v := range ngFilterActions {
+		if v == a {
+			return k
+		}
+	}
+	return pb.NGFilterAction_NG_FILTER_ACTION_UNSPECIFIED
+}
+
+func toReactionInput(req *pb.ReactionRequest) service.ReactionInput {
+	return service.ReactionInput{
+		MessageID: req.GetMessageId(),
+		MemberID:  req.GetMemberId(),
+		Emoji:     req.GetEmoji(),
+	}
+}
+
+func toPBReactionChange(c *service.ReactionChange) *pb.ReactionChange {
+	return &pb.ReactionChange{
+		MessageId: c.MessageID,
+		MemberId:  c.MemberID,
+		Emoji:     c.Emoji,
+		Count:     c.Count,
+		Changed:   c.Changed,
+	}
+}
+
+func toPBReactionSummaries(summaries []repository.ReactionSummary) []*pb.ReactionSummary {
+	res := make([]*pb.ReactionSummary, len(summaries))
+	for i, r := range summaries {
+		res[i] = &pb.ReactionSummary{
+			Emoji:     r.Emoji,
+			Count:     r.Count,
+			MemberIds: r.MemberIDs,
+		}
+	}
+	return res
+}
@@ -0,0 +1,48 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/ngfilter"
+	"github.com/noxhalley/funken/internal/service"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatus maps service and repository errors to gRPC status codes.
+func toStatus(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrNGFilterMatched):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ngfilter.ErrInvalidFilter),
+		errors.Is(err, ngfilter.ErrInvalidImport):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrNotMember),
+		errors.Is(err, service.ErrNotSender):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrGroupLocked):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrEditConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return status.Error(codes.NotFound, "resource not found")
+	case mongo.IsDuplicateKeyError(err):
+		return status.Error(codes.AlreadyExists, "resource already exists")
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		log.Error(ctx, "failed to handle call", "error", err)
+		return status.Error(codes.Internal, codes.Internal.String())
+	}
+}
+
+func invalidArgument(msg string) error {
+	return status.Error(codes.InvalidArgument, msg)
+}
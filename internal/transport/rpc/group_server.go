@@ -0,0 +1,272 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/noxhalley/funken/internal/event"
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/infrastructure/pubsub"
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/model"
+	"github.com/noxhalley/funken/pkg/pb"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	watchBuffer = 256
+)
+
+type GroupServer struct {
+	pb.UnimplementedGroupServiceServer
+
+	logger    *log.Logger
+	groupRepo repository.GroupRepository
+	watcher   pubsub.Watcher
+}
+
+func NewGroupServer(groupRepo repository.GroupRepository, watcher pubsub.Watcher) *GroupServer {
+	return &GroupServer{
+		logger:    log.With("service", "grpc_group_server"),
+		groupRepo: groupRepo,
+		watcher:   watcher,
+	}
+}
+
+// Register implements Service.
+func (s *GroupServer) Register(srv *grpc.Server) {
+	pb.RegisterGroupServiceServer(srv, s)
+}
+
+// CreateGroup implements pb.GroupServiceServer.
+func (s *GroupServer) CreateGroup(ctx context.Context, req *pb.CreateGroupRequest) (*pb.Group, error) {
+	now := time.Now()
+	group := model.Group{
+		BaseModel: model.BaseModel{
+			ID:        req.GetId(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		Meta:   fromStruct(req.GetMeta()),
+		Status: model.GroupStatus(req.GetStatus()),
+	}
+
+	if group.ID == "" {
+		group.ID = uuid.NewString()
+	}
+	if !model.IsValidGroupID(group.ID) {
+		return nil, invalidArgument("group id may only hold letters, digits, '_' and '-'")
+	}
+	if group.Status == 0 {
+		group.Status = model.GroupStatusActive
+	}
+	if !group.Status.IsValid() {
+		return nil, invalidArgument("invalid group status")
+	}
+	if req.GetRetention() != nil {
+		group.Retention = fromPBRetention(req.GetRetention())
+		if !group.Retention.IsValid() {
+			return nil, invalidArgument("invalid retention")
+		}
+	}
+	if req.GetAttachmentPolicy() != nil {
+		group.AttachmentPolicy = fromPBAttachmentPolicy(req.GetAttachmentPolicy())
+		if !group.AttachmentPolicy.IsValid() {
+			return nil, invalidArgument("invalid attachment policy")
+		}
+	}
+
+	if err := s.groupRepo.Create(ctx, group); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return toPBGroup(&group), nil
+}
+
+// GetGroup implements pb.GroupServiceServer.
+func (s *GroupServer) GetGroup(ctx context.Context, req *pb.GetGroupRequest) (*pb.Group, error) {
+	group, err := s.groupRepo.FindOneByConditions(ctx, bson.M{"id": req.GetId()}, nil)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return toPBGroup(group), nil
+}
+
+// ListGroups implements pb.GroupServiceServer.
+func (s *GroupServer) ListGroups(ctx context.Context, req *pb.ListGroupsRequest) (*pb.ListGroupsResponse, error) {
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize || req.GetOffset() < 0 {
+		return nil, invalidArgument("limit or offset out of range")
+	}
+
+	filter := bson.M{}
+	if req.GetStatus() != pb.GroupStatus_GROUP_STATUS_UNSPECIFIED {
+		filter["status"] = model.GroupStatus(req.GetStatus())
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(req.GetOffset()).
+		SetLimit(limit)
+
+	groups, err := s.groupRepo.FindByConditions(ctx, filter, opts)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	res := &pb.ListGroupsResponse{Groups: make([]*pb.Group, len(groups))}
+	for i := range groups {
+		res.Groups[i] = toPBGroup(&groups[i])
+	}
+	return res, nil
+}
+
+// UpdateGroup implements pb.GroupServiceServer.
+func (s *GroupServer) UpdateGroup(ctx context.Context, req *pb.UpdateGroupRequest) (*pb.Group, error) {
+	set := bson.M{"updated_at": time.Now()}
+	if req.GetMeta() != nil {
+		set["meta"] = fromStruct(req.GetMeta())
+	}
+	if req.GetStatus() != pb.GroupStatus_GROUP_STATUS_UNSPECIFIED {
+		status := model.GroupStatus(req.GetStatus())
+		if !status.IsValid() {
+			return nil, invalidArgument("invalid group status")
+		}
+		set["status"] = status
+	}
+	if req.GetRetention() != nil {
+		retention := fromPBRetention(req.GetRetention())
+		if !retention.IsValid() {
+			return nil, invalidArgument("invalid retention")
+		}
+		set["retention"] = retention
+	}
+	if req.GetAttachmentPolicy() != nil {
+		policy := fromPBAttachmentPolicy(req.GetAttachmentPolicy())
+		if !policy.IsValid() {
+			return nil, invalidArgument("invalid attachment policy")
+		}
+		set["attachment_policy"] = policy
+	}
+
+	group, err := s.groupRepo.UpdateByID(ctx, req.GetId(), bson.M{"$set": set})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return toPBGroup(group), nil
+}
+
+// DeleteGroup implements pb.GroupServiceServer.
+func (s *GroupServer) DeleteGroup(ctx context.Context, req *pb.DeleteGroupRequest) (*emptypb.Empty, error) {
+	if err := s.groupRepo.DeleteByID(ctx, req.GetId()); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CheckGroupExists implements pb.GroupServiceServer.
+func (s *GroupServer) CheckGroupExists(
+	ctx context.Context,
+	req *pb.CheckGroupExistsRequest,
+) (*pb.CheckGroupExistsResponse, error) {
+	exists, err := s.groupRepo.CheckExist(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &pb.CheckGroupExistsResponse{Exists: exists}, nil
+}
+
+// WatchGroup implements pb.GroupServiceServer. Events are buffered between
+// the JetStream callback and the stream; a caller that falls too far behind
+// gets ResourceExhausted and can resume from the last sequence it saw.
+func (s *GroupServer) WatchGroup(req *pb.WatchGroupRequest, stream grpc.ServerStreamingServer[pb.GroupEvent]) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	groupID := req.GetGroupId()
+	exists, err := s.groupRepo.CheckExist(ctx, groupID)
+	if err != nil {
+		return toStatus(ctx, err)
+	}
+	if !exists {
+		return status.Error(codes.NotFound, "group not found")
+	}
+
+	subject := event.GroupSubject(groupID)
+	if req.GetThreadId() != "" {
+		subject = event.ThreadSubject(groupID, req.GetThreadId())
+	}
+
+	events := make(chan pubsub.WatchedMsg, watchBuffer)
+	overflow := make(chan struct{})
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- s.watcher.Watch(ctx, pubsub.WatchParams{
+			Stream:         event.GroupStream,
+			FilterSubjects: []string{subject},
+			StartSeq:       req.GetStartSequence(),
+		}, func(msg pubsub.WatchedMsg) {
+			select {
+			case events <- msg:
+			case <-overflow:
+			default:
+				close(overflow)
+			}
+		})
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return toStatus(ctx, ctx.Err())
+		case <-overflow:
+			return status.Error(codes.ResourceExhausted, "watcher fell behind, resume from the last sequence")
+		case err := <-watchErr:
+			if ctx.Err() != nil {
+				return toStatus(ctx, ctx.Err())
+			}
+			return toStatus(ctx, err)
+		case msg := <-events:
+			if err := stream.Send(toPBGroupEvent(ctx, msg)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func toPBGroupEvent(ctx context.Context, msg pubsub.WatchedMsg) *pb.GroupEvent {
+	evt := struct {
+		Type       event.Type      `json:"type"`
+		GroupID    string          `json:"group_id"`
+		ThreadID   string          `json:"thread_id"`
+		Data       json.RawMessage `json:"data"`
+		OccurredAt time.Time       `json:"occurred_at"`
+	}{}
+	if err := json.Unmarshal(msg.Data, &evt); err != nil {
+		log.Warn(ctx, "failed to decode group event", "sequence", msg.Sequence, "error", err)
+	}
+
+	res := &pb.GroupEvent{
+		Sequence:   msg.Sequence,
+		Type:       string(evt.Type),
+		GroupId:    evt.GroupID,
+		ThreadId:   evt.ThreadID,
+		OccurredAt: toTimestamp(evt.OccurredAt),
+	}
+	if len(evt.Data) > 0 {
+		res.Data = toStruct(evt.Data)
+	}
+	return res
+}
@@ -0,0 +1,158 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// logMetadataPrefix marks metadata entries carrying log context values
+// between services, e.g. x-log-request_id.
+const logMetadataPrefix = "x-log-"
+
+// withLogContext copies x-log-* metadata into the log context map so that
+// every record logged while serving the call carries the caller's values.
+func withLogContext(ctx context.Context, fullMethod string) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for key, vals := range md {
+			name, found := strings.CutPrefix(key, logMetadataPrefix)
+			if !found || name == "" || len(vals) == 0 {
+				continue
+			}
+			ctx = log.AddLogValToCtx(ctx, name, vals[0])
+		}
+	}
+
+	if _, ok := log.LogValsFromCtx(ctx)["request_id"]; !ok {
+		ctx = log.AddLogValToCtx(ctx, "request_id", uuid.NewString())
+	}
+	return log.AddLogValToCtx(ctx, "grpc_method", fullMethod)
+}
+
+func unaryLogContextInterceptor(logger *log.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		ctx = withLogContext(ctx, info.FullMethod)
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		logger.Debug(ctx, "gRPC call handled", "code", status.Code(err).String(), "latency", time.Since(start))
+		return resp, err
+	}
+}
+
+func streamLogContextInterceptor(logger *log.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := withLogContext(ss.Context(), info.FullMethod)
+		start := time.Now()
+
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+
+		logger.Debug(ctx, "gRPC stream closed", "code", status.Code(err).String(), "latency", time.Since(start))
+		return err
+	}
+}
+
+func unaryRecoveryInterceptor(logger *log.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp any, err error) {
+		defer func() {
+			if v := recover(); v != nil {
+				logger.Error(ctx, "panic while handling call", "panic", v, "stack", string(debug.Stack()))
+				err = status.Error(codes.Internal, codes.Internal.String())
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+func streamRecoveryInterceptor(logger *log.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		defer func() {
+			if v := recover(); v != nil {
+				logger.Error(ss.Context(), "panic while handling stream", "panic", v, "stack", string(debug.Stack()))
+				err = status.Error(codes.Internal, codes.Internal.String())
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+// UnaryClientInterceptor forwards the log context map of the calling
+// context as x-log-* metadata, for services calling funken or each other.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		return invoker(outgoingLogContext(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor is the streaming counterpart of UnaryClientInterceptor.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		return streamer(outgoingLogContext(ctx), desc, cc, method, opts...)
+	}
+}
+
+func outgoingLogContext(ctx context.Context) context.Context {
+	vals := log.LogValsFromCtx(ctx)
+	if len(vals) == 0 {
+		return ctx
+	}
+
+	kv := make([]string, 0, len(vals)*2)
+	for key, val := range vals {
+		kv = append(kv, logMetadataPrefix+strings.ToLower(key), fmt.Sprint(val))
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,161 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/service"
+	"github.com/noxhalley/funken/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type MemberGroupServer struct {
+	pb.UnimplementedMemberGroupServiceServer
+
+	memberGroupRepo repository.MemberGroupRepository
+	messageSvc      service.MessageService
+}
+
+func NewMemberGroupServer(
+	memberGroupRepo repository.MemberGroupRepository,
+	messageSvc service.MessageService,
+) *MemberGroupServer {
+	return &MemberGroupServer{
+		memberGroupRepo: memberGroupRepo,
+		messageSvc:      messageSvc,
+	}
+}
+
+// Register implements Service.
+func (s *MemberGroupServer) Register(srv *grpc.Server) {
+	pb.RegisterMemberGroupServiceServer(srv, s)
+}
+
+// ListMemberIDs implements pb.MemberGroupServiceServer.
+func (s *MemberGroupServer) ListMemberIDs(
+	ctx context.Context,
+	req *pb.ListMemberIDsRequest,
+) (*pb.ListMemberIDsResponse, error) {
+	ids, err := s.memberGroupRepo.FindMemberIDsByGroupID(ctx, req.GetGroupId())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &pb.ListMemberIDsResponse{MemberIds: ids}, nil
+}
+
+// CountMembers implements pb.MemberGroupServiceServer.
+func (s *MemberGroupServer) CountMembers(
+	ctx context.Context,
+	req *pb.CountMembersRequest,
+) (*pb.CountMembersResponse, error) {
+	count, err := s.memberGroupRepo.CountMembersByGroupID(ctx, req.GetGroupId())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &pb.CountMembersResponse{Count: count}, nil
+}
+
+// AddMembers implements pb.MemberGroupServiceServer.
+func (s *MemberGroupServer) AddMembers(ctx context.Context, req *pb.AddMembersRequest) (*emptypb.Empty, error) {
+	if req.GetGroupId() == "" || len(req.GetMemberIds()) == 0 {
+		return nil, invalidArgument("group_id and member_ids are required")
+	}
+
+	if err := s.memberGroupRepo.AddMembers(ctx, req.GetGroupId(), req.GetMemberIds()); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveMembers implements pb.MemberGroupServiceServer.
+func (s *MemberGroupServer) RemoveMembers(ctx context.Context, req *pb.RemoveMembersRequest) (*emptypb.Empty, error) {
+	if req.GetGroupId() == "" || len(req.GetMemberIds()) == 0 {
+		return nil, invalidArgument("group_id and member_ids are required")
+	}
+
+	if err := s.memberGroupRepo.RemoveMembers(ctx, req.GetGroupId(), req.GetMemberIds()); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// IsMember implements pb.MemberGroupServiceServer.
+func (s *MemberGroupServer) IsMember(ctx context.Context, req *pb.IsMemberRequest) (*pb.IsMemberResponse, error) {
+	isMember, err := s.memberGroupRepo.IsMember(ctx, req.GetGroupId(), req.GetMemberId())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &pb.IsMemberResponse{IsMember: isMember}, nil
+}
+
+// MarkRead implements pb.MemberGroupServiceServer.
+func (s *MemberGroupServer) MarkRead(ctx context.Context, req *pb.MarkReadRequest) (*pb.ReadMarker, error) {
+	memberGroup, err := s.messageSvc.MarkRead(ctx, service.MarkReadInput{
+		GroupID:   req.GetGroupId(),
+		MemberID:  req.GetMemberId(),
+		MessageID: req.GetMessageId(),
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	marker := &pb.ReadMarker{
+		GroupId:           memberGroup.GroupID,
+		MemberId:          memberGroup.MemberID,
+		LastReadMessageId: memberGroup.LastReadMessageID,
+	}
+	if memberGroup.LastReadAt != nil {
+		marker.LastReadAt = toTimestamp(*memberGroup.LastReadAt)
+	}
+	return marker, nil
+}
+
+// GetUnreadCounts implements pb.MemberGroupServiceServer.
+func (s *MemberGroupServer) GetUnreadCounts(
+	ctx context.Context,
+	req *pb.GetUnreadCountsRequest,
+) (*pb.GetUnreadCountsResponse, error) {
+	if req.GetMemberId() == "" {
+		return nil, invalidArgument("member_id is required")
+	}
+
+	counts, err := s.messageSvc.UnreadCounts(ctx, req.GetMemberId())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	res := &pb.GetUnreadCountsResponse{Counts: make([]*pb.UnreadCount, len(counts))}
+	for i, c := range counts {
+		res.Counts[i] = &pb.UnreadCount{GroupId: c.GroupID, Count: c.Count}
+	}
+	return res, nil
+}
+
+// SetMute implements pb.MemberGroupServiceServer.
+func (s *MemberGroupServer) SetMute(ctx context.Context, req *pb.SetMuteRequest) (*pb.MuteSetting, error) {
+	input := service.SetMuteInput{
+		GroupID:  req.GetGroupId(),
+		MemberID: req.GetMemberId(),
+		Muted:    req.GetMuted(),
+	}
+	if req.GetUntil() != nil {
+		until := req.GetUntil().AsTime()
+		input.Until = &until
+	}
+
+	memberGroup, err := s.messageSvc.SetMute(ctx, input)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	setting := &pb.MuteSetting{
+		GroupId:  memberGroup.GroupID,
+		MemberId: memberGroup.MemberID,
+		Muted:    memberGroup.Muted,
+	}
+	if memberGroup.MutedUntil != nil {
+		setting.Until = toTimestamp(*memberGroup.MutedUntil)
+	}
+	return setting, nil
+}
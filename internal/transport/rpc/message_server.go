@@ -0,0 +1,295 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/service"
+	"github.com/noxhalley/funken/pkg/pb"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	defaultMessagePageSize = 50
+	maxMessagePageSize     = 100
+)
+
+type MessageServer struct {
+	pb.UnimplementedMessageServiceServer
+
+	messageRepo repository.MessageRepository
+	messageSvc  service.MessageService
+}
+
+func NewMessageServer(
+	messageRepo repository.MessageRepository,
+	messageSvc service.MessageService,
+) *MessageServer {
+	return &MessageServer{
+		messageRepo: messageRepo,
+		messageSvc:  messageSvc,
+	}
+}
+
+// Register implements Service.
+func (s *MessageServer) Register(srv *grpc.Server) {
+	pb.RegisterMessageServiceServer(srv, s)
+}
+
+// SendMessage implements pb.MessageServiceServer.
+func (s *MessageServer) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*pb.Message, error) {
+	msg, err := s.messageSvc.Send(ctx, service.SendMessageInput{
+		GroupID:       req.GetGroupId(),
+		SenderID:      req.GetSenderId(),
+		Message:       req.GetMessage(),
+		Mentions:      req.GetMentions(),
+		Priority:      req.GetPriority(),
+		Nickname:      req.GetNickname(),
+		IPAddress:     req.GetIpAddress(),
+		ParentID:      req.GetParentId(),
+		AttachmentIDs: req.GetAttachmentIds(),
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return toPBMessage(msg), nil
+}
+
+// GetMessage implements pb.MessageServiceServer.
+func (s *MessageServer) GetMessage(ctx context.Context, req *pb.GetMessageRequest) (*pb.Message, error) {
+	var filter interface{} = bson.M{"id": req.GetId()}
+	if req.GetIncludeDeleted() {
+		filter = repository.WithDeleted(bson.M{"id": req.GetId()})
+	}
+
+	msg, err := s.messageRepo.FindOneByConditions(ctx, filter, nil)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	// the sender's address is for moderation, not for listings
+	res := toPBMessage(msg)
+	res.IpAddress = msg.IPAddress
+	return res, nil
+}
+
+// ListMessages implements pb.MessageServiceServer.
+func (s *MessageServer) ListMessages(
+	ctx context.Context,
+	req *pb.ListMessagesRequest,
+) (*pb.ListMessagesResponse, error) {
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = defaultMessagePageSize
+	}
+	if limit > maxMessagePageSize {
+		return nil, invalidArgument("limit out of range")
+	}
+
+	page, err := s.messageSvc.ListHistory(ctx, service.ListHistoryInput{
+		GroupID:        req.GetGroupId(),
+		Direction:      toSortDirection(req.GetDirection()),
+		Cursor:         req.GetCursor(),
+		Limit:          limit,
+		ViewerID:       req.GetViewerId(),
+		Flagged:        req.GetFlagged(),
+		IncludeDeleted: req.GetIncludeDeleted(),
+		ThreadID:       req.GetThreadId(),
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.ListMessagesResponse{
+		Messages:   toPBMessages(page.Messages),
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+	}, nil
+}
+
+// ListThreads implements pb.MessageServiceServer.
+func (s *MessageServer) ListThreads(
+	ctx context.Context,
+	req *pb.ListThreadsRequest,
+) (*pb.ListThreadsResponse, error) {
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = defaultMessagePageSize
+	}
+	if limit > maxMessagePageSize {
+		return nil, invalidArgument("limit out of range")
+	}
+
+	page, err := s.messageSvc.ListThreads(ctx, service.ListThreadsInput{
+		GroupID:  req.GetGroupId(),
+		Cursor:   req.GetCursor(),
+		Limit:    limit,
+		ViewerID: req.GetViewerId(),
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.ListThreadsResponse{
+		Threads:    toPBMessages(page.Threads),
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+// CountMessages implements pb.MessageServiceServer.
+func (s *MessageServer) CountMessages(
+	ctx context.Context,
+	req *pb.CountMessagesRequest,
+) (*pb.CountMessagesResponse, error) {
+	if req.GetGroupId() == "" {
+		return nil, invalidArgument("group_id is required")
+	}
+
+	filter := bson.M{
+		"group_id":   req.GetGroupId(),
+		"deleted_at": nil,
+	}
+	if req.GetSenderId() != "" {
+		filter["sender_id"] = req.GetSenderId()
+	}
+
+	count, err := s.messageRepo.CountByConditions(ctx, filter, nil)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &pb.CountMessagesResponse{Count: count}, nil
+}
+
+// DeleteMessage implements pb.MessageServiceServer.
+func (s *MessageServer) DeleteMessage(ctx context.Context, req *pb.DeleteMessageRequest) (*emptypb.Empty, error) {
+	if _, err := s.messageSvc.Delete(ctx, service.DeleteMessageInput{ID: req.GetId()}); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RestoreMessage implements pb.MessageServiceServer.
+func (s *MessageServer) RestoreMessage(ctx context.Context, req *pb.RestoreMessageRequest) (*pb.Message, error) {
+	msg, err := s.messageSvc.Restore(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return toPBMessage(msg), nil
+}
+
+// EditMessage implements pb.MessageServiceServer.
+func (s *MessageServer) EditMessage(ctx context.Context, req *pb.EditMessageRequest) (*pb.Message, error) {
+	msg, err := s.messageSvc.Edit(ctx, service.EditMessageInput{
+		ID:       req.GetId(),
+		EditorID: req.GetEditorId(),
+		Message:  req.GetMessage(),
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return toPBMessage(msg), nil
+}
+
+// ListMessageRevisions implements pb.MessageServiceServer.
+func (s *MessageServer) ListMessageRevisions(
+	ctx context.Context,
+	req *pb.ListMessageRevisionsRequest,
+) (*pb.ListMessageRevisionsResponse, error) {
+	revisions, err := s.messageSvc.ListRevisions(ctx, service.ListRevisionsInput{ID: req.GetMessageId()})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &pb.ListMessageRevisionsResponse{Revisions: toPBMessageRevisions(revisions)}, nil
+}
+
+// AddReaction implements pb.MessageServiceServer.
+func (s *MessageServer) AddReaction(ctx context.Context, req *pb.ReactionRequest) (*pb.ReactionChange, error) {
+	change, err := s.messageSvc.AddReaction(ctx, toReactionInput(req))
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return toPBReactionChange(change), nil
+}
+
+// RemoveReaction implements pb.MessageServiceServer.
+func (s *MessageServer) RemoveReaction(ctx context.Context, req *pb.ReactionRequest) (*pb.ReactionChange, error) {
+	change, err := s.messageSvc.RemoveReaction(ctx, toReactionInput(req))
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return toPBReactionChange(change), nil
+}
+
+// ListReactions implements pb.MessageServiceServer.
+func (s *MessageServer) ListReactions(
+	ctx context.Context,
+	req *pb.ListReactionsRequest,
+) (*pb.ListReactionsResponse, error) {
+	reactions, err := s.messageSvc.ListReactions(ctx, service.ListReactionsInput{ID: req.GetMessageId()})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &pb.ListReactionsResponse{Reactions: toPBReactionSummaries(reactions)}, nil
+}
+
+// ListMessageReaders implements pb.MessageServiceServer.
+func (s *MessageServer) ListMessageReaders(
+	ctx context.Context,
+	req *pb.ListMessageReadersRequest,
+) (*pb.ListMessageReadersResponse, error) {
+	readers, err := s.messageSvc.ListReaders(ctx, service.ListReadersInput{ID: req.GetMessageId()})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &pb.ListMessageReadersResponse{MemberIds: readers}, nil
+}
+
+// SearchMessages implements pb.MessageServiceServer.
+func (s *MessageServer) SearchMessages(
+	ctx context.Context,
+	req *pb.SearchMessagesRequest,
+) (*pb.SearchMessagesResponse, error) {
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = defaultMessagePageSize
+	}
+	if limit > maxMessagePageSize {
+		return nil, invalidArgument("limit out of range")
+	}
+
+	input := service.SearchInput{
+		Query:    req.GetQuery(),
+		GroupID:  req.GetGroupId(),
+		SenderID: req.GetSenderId(),
+		Mention:  req.GetMention(),
+		Priority: req.Priority,
+		Sort:     toSearchSort(req.GetSort()),
+		Cursor:   req.GetCursor(),
+		Limit:    limit,
+		ViewerID: req.GetViewerId(),
+	}
+	if req.GetFrom() != nil {
+		from := req.GetFrom().AsTime()
+		input.From = &from
+	}
+	if req.GetTo() != nil {
+		to := req.GetTo().AsTime()
+		input.To = &to
+	}
+
+	page, err := s.messageSvc.Search(ctx, input)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	res := &pb.SearchMessagesResponse{
+		Hits:       make([]*pb.SearchHit, len(page.Hits)),
+		NextCursor: page.NextCursor,
+	}
+	for i := range page.Hits {
+		res.Hits[i] = toPBSearchHit(&page.Hits[i])
+	}
+	return res, nil
+}
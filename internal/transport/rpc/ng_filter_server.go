@@ -0,0 +1,459 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/model"
+	"github.com/noxhalley/funken/internal/ngfilter"
+	"github.com/noxhalley/funken/pkg/pb"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxTestSamples      = 50
+	maxTestSampleLength = 4000
+
+	defaultFilterStatsWindow = 7 * 24 * time.Hour
+	defaultFilterHitPageSize = 50
+	maxFilterHitPageSize     = 500
+)
+
+type NGFilterServer struct {
+	pb.UnimplementedGroupNGFilterServiceServer
+
+	ngFilterRepo    repository.GroupNGFilterRepository
+	ngFilterHitRepo repository.NGFilterHitRepository
+	validator       *ngfilter.Validator
+	ngFilterEngine  ngfilter.Engine
+	transfer        *ngfilter.Transfer
+}
+
+func NewNGFilterServer(
+	ngFilterRepo repository.GroupNGFilterRepository,
+	ngFilterHitRepo repository.NGFilterHitRepository,
+	validator *ngfilter.Validator,
+	ngFilterEngine ngfilter.Engine,
+	transfer *ngfilter.Transfer,
+) *NGFilterServer {
+	return &NGFilterServer{
+		ngFilterRepo:    ngFilterRepo,
+		ngFilterHitRepo: ngFilterHitRepo,
+		validator:       validator,
+		ngFilterEngine:  ngFilterEngine,
+		transfer:        transfer,
+	}
+}
+
+// Register implements Service.
+func (s *NGFilterServer) Register(srv *grpc.Server) {
+	pb.RegisterGroupNGFilterServiceServer(srv, s)
+}
+
+func newNGFilter(input *pb.NGFilterInput, now time.Time) (model.GroupNGFilter, error) {
+	if input.GetTitle() == "" || input.GetPattern() == "" {
+		return model.GroupNGFilter{}, invalidArgument("title and pattern are required")
+	}
+
+	action := toNGFilterAction(input.GetAction())
+	if action == "" && input.GetAction() != pb.NGFilterAction_NG_FILTER_ACTION_UNSPECIFIED {
+		return model.GroupNGFilter{}, invalidArgument("unknown action")
+	}
+
+	return model.GroupNGFilter{
+		BaseModel: model.BaseModel{
+			ID:        uuid.NewString(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		GroupID: input.GetGroupId(),
+		Title:   input.GetTitle(),
+		Pattern: input.GetPattern(),
+		Flags:   input.GetFlags(),
+		Action:  action,
+	}, nil
+}
+
+// CreateFilter implements pb.GroupNGFilterServiceServer.
+func (s *NGFilterServer) CreateFilter(ctx context.Context, req *pb.CreateFilterRequest) (*pb.GroupNGFilter, error) {
+	ngFilter, err := newNGFilter(req.GetFilter(), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ngFilterRepo.Create(ctx, ngFilter); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return toPBNGFilter(&ngFilter), nil
+}
+
+// CreateFilters implements pb.GroupNGFilterServiceServer.
+func (s *NGFilterServer) CreateFilters(
+	ctx context.Context,
+	req *pb.CreateFiltersRequest,
+) (*pb.CreateFiltersResponse, error) {
+	if len(req.GetFilters()) == 0 {
+		return nil, invalidArgument("filters must not be empty")
+	}
+
+	now := time.Now()
+	ngFilters := make([]model.GroupNGFilter, len(req.GetFilters()))
+	for i, input := range req.GetFilters() {
+		ngFilter, err := newNGFilter(input, now)
+		if err != nil {
+			return nil, err
+		}
+		ngFilters[i] = ngFilter
+	}
+
+	if err := s.ngFilterRepo.CreateBatch(ctx, ngFilters); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &pb.CreateFiltersResponse{Filters: toPBNGFilters(ngFilters)}, nil
+}
+
+// GetFilter implements pb.GroupNGFilterServiceServer.
+func (s *NGFilterServer) GetFilter(ctx context.Context, req *pb.GetFilterRequest) (*pb.GroupNGFilter, error) {
+	ngFilter, err := s.ngFilterRepo.FindOneByConditions(ctx, bson.M{"id": req.GetId()}, nil)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return toPBNGFilter(ngFilter), nil
+}
+
+// ListFilters implements pb.GroupNGFilterServiceServer.
+func (s *NGFilterServer) ListFilters(ctx context.Context, req *pb.ListFiltersRequest) (*pb.ListFiltersResponse, error) {
+	var (
+		ngFilters []model.GroupNGFilter
+		err       error
+	)
+	if req.GetGroupId() == "" {
+		ngFilters, err = s.ngFilterRepo.FindGlobal(ctx)
+	} else {
+		ngFilters, err = s.ngFilterRepo.FindByConditions(ctx, bson.M{"group_id": req.GetGroupId()}, nil)
+	}
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &pb.ListFiltersResponse{Filters: toPBNGFilters(ngFilters)}, nil
+}
+
+// UpdateFilter implements pb.GroupNGFilterServiceServer.
+func (s *NGFilterServer) UpdateFilter(ctx context.Context, req *pb.UpdateFilterRequest) (*pb.GroupNGFilter, error) {
+	set := bson.M{"updated_at": time.Now()}
+	if req.Title != nil {
+		set["title"] = req.GetTitle()
+	}
+	if req.Pattern != nil {
+		if req.GetPattern() == "" {
+			return nil, invalidArgument("pattern must not be empty")
+		}
+		set["pattern"] = req.GetPattern()
+	}
+	if req.Flags != nil {
+		set["flags"] = req.GetFlags()
+	}
+	if req.Action != nil {
+		action := toNGFilterAction(req.GetAction())
+		if action == "" {
+			return nil, invalidArgument("action must be specified")
+		}
+		set["action"] = action
+	}
+
+	ngFilter, err := s.ngFilterRepo.UpdateByID(ctx, req.GetId(), bson.M{"$set": set})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return toPBNGFilter(ngFilter), nil
+}
+
+// DeleteFilter implements pb.GroupNGFilterServiceServer.
+func (s *NGFilterServer) DeleteFilter(ctx context.Context, req *pb.DeleteFilterRequest) (*emptypb.Empty, error) {
+	if err := s.ngFilterRepo.DeleteByID(ctx, req.GetId()); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteFiltersByGroupIDs implements pb.GroupNGFilterServiceServer.
+func (s *NGFilterServer) DeleteFiltersByGroupIDs(
+	ctx context.Context,
+	req *pb.DeleteFiltersByGroupIDsRequest,
+) (*emptypb.Empty, error) {
+	if len(req.GetGroupIds()) == 0 {
+		return nil, invalidArgument("group_ids must not be empty")
+	}
+
+	if err := s.ngFilterRepo.DeleteByGroupIDs(ctx, req.GetGroupIds()); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// SetGlobalFilterOptOut implements pb.GroupNGFilterServiceServer.
+func (s *NGFilterServer) SetGlobalFilterOptOut(
+	ctx context.Context,
+	req *pb.SetGlobalFilterOptOutRequest,
+) (*emptypb.Empty, error) {
+	if req.GetGroupId() == "" || req.GetFilterId() == "" {
+		return nil, invalidArgument("group_id and filter_id are required")
+	}
+
+	if err := s.ngFilterEngine.SetGlobalOptOut(ctx, req.GetGroupId(), req.GetFilterId(), req.GetOptOut()); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetEffectiveFilters implements pb.GroupNGFilterServiceServer.
+func (s *NGFilterServer) GetEffectiveFilters(
+	ctx context.Context,
+	req *pb.GetEffectiveFiltersRequest,
+) (*pb.ListFiltersResponse, error) {
+	if req.GetGroupId() == "" {
+		return nil, invalidArgument("group_id is required")
+	}
+
+	ngFilters, err := s.ngFilterEngine.EffectiveFilters(ctx, req.GetGroupId())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &pb.ListFiltersResponse{Filters: toPBNGFilters(ngFilters)}, nil
+}
+
+// GetFilterStats implements pb.GroupNGFilterServiceServer.
+func (s *NGFilterServer) GetFilterStats(
+	ctx context.Context,
+	req *pb.GetFilterStatsRequest,
+) (*pb.GetFilterStatsResponse, error) {
+	from, to, err := statsWindow(req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+
+	params := repository.NGFilterHitStatsParams{
+		GroupID:  req.GetGroupId(),
+		FilterID: req.GetFilterId(),
+		From:     from,
+		To:       to,
+		By:       repository.NGFilterHitsByFilter,
+	}
+	if req.GetGroupBy() == pb.FilterStatsGrouping_FILTER_STATS_GROUPING_GROUP {
+		params.By = repository.NGFilterHitsByGroup
+	}
+	switch req.GetBucket() {
+	case pb.FilterStatsBucket_FILTER_STATS_BUCKET_HOUR:
+		params.Bucket = repository.NGFilterHitBucketHour
+	case pb.FilterStatsBucket_FILTER_STATS_BUCKET_DAY:
+		params.Bucket = repository.NGFilterHitBucketDay
+	}
+
+	stats, err := s.ngFilterHitRepo.Stats(ctx, params)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	res := &pb.GetFilterStatsResponse{Stats: make([]*pb.FilterStat, len(stats))}
+	for i, stat := range stats {
+		res.Stats[i] = &pb.FilterStat{
+			FilterId:  stat.FilterID,
+			GroupId:   stat.GroupID,
+			Count:     stat.Count,
+			LastHitAt: toTimestamp(stat.LastHitAt),
+		}
+		if stat.Bucket != nil {
+			res.Stats[i].Bucket = toTimestamp(*stat.Bucket)
+		}
+	}
+	return res, nil
+}
+
+// ListFilterHits implements pb.GroupNGFilterServiceServer.
+func (s *NGFilterServer) ListFilterHits(
+	ctx context.Context,
+	req *pb.ListFilterHitsRequest,
+) (*pb.ListFilterHitsResponse, error) {
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = defaultFilterHitPageSize
+	}
+	if limit > maxFilterHitPageSize {
+		return nil, invalidArgument("limit out of range")
+	}
+
+	from, to, err := statsWindow(req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{
+		"created_at": bson.M{
+			"$gte": from,
+			"$lt":  to,
+		},
+	}
+	if req.GetGroupId() != "" {
+		filter["group_id"] = req.GetGroupId()
+	}
+	if req.GetFilterId() != "" {
+		filter["filter_id"] = req.GetFilterId()
+	}
+	if req.GetSenderId() != "" {
+		filter["sender_id"] = req.GetSenderId()
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetLimit(limit)
+
+	hits, err := s.ngFilterHitRepo.FindByConditions(ctx, filter, opts)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &pb.ListFilterHitsResponse{Hits: toPBFilterHits(hits)}, nil
+}
+
+func statsWindow(from, to *timestamppb.Timestamp) (time.Time, time.Time, error) {
+	end := time.Now()
+	if to != nil {
+		end = to.AsTime()
+	}
+	start := end.Add(-defaultFilterStatsWindow)
+	if from != nil {
+		start = from.AsTime()
+	}
+
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, invalidArgument("from must be before to")
+	}
+	return start, end, nil
+}
+
+var filterListFormats = map[pb.FilterListFormat]ngfilter.Format{
+	pb.FilterListFormat_FILTER_LIST_FORMAT_CSV:  ngfilter.FormatCSV,
+	pb.FilterListFormat_FILTER_LIST_FORMAT_JSON: ngfilter.FormatJSON,
+}
+
+// ImportFilters implements pb.GroupNGFilterServiceServer.
+func (s *NGFilterServer) ImportFilters(
+	ctx context.Context,
+	req *pb.ImportFiltersRequest,
+) (*pb.ImportFiltersResponse, error) {
+	format, ok := filterListFormats[req.GetFormat()]
+	if !ok {
+		return nil, invalidArgument("format must be specified")
+	}
+
+	mode := ngfilter.ImportMerge
+	if req.GetMode() == pb.ImportMode_IMPORT_MODE_REPLACE {
+		mode = ngfilter.ImportReplace
+	}
+
+	report, err := s.transfer.Import(ctx, ngfilter.ImportInput{
+		GroupID: req.GetGroupId(),
+		Format:  format,
+		Data:    bytes.NewReader(req.GetData()),
+		Mode:    mode,
+		DryRun:  req.GetDryRun(),
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	res := &pb.ImportFiltersResponse{
+		Created:    toPBNGFilters(report.Created),
+		Updated:    toPBNGFilters(report.Updated),
+		Deleted:    toPBNGFilters(report.Deleted),
+		Duplicates: report.Duplicates,
+		Invalid:    make([]*pb.InvalidFilterEntry, len(report.Invalid)),
+		Applied:    report.Applied,
+	}
+	for i, entry := range report.Invalid {
+		res.Invalid[i] = &pb.InvalidFilterEntry{
+			Line:    int32(entry.Line),
+			Pattern: entry.Pattern,
+			Error:   entry.Error,
+		}
+	}
+	return res, nil
+}
+
+// ExportFilters implements pb.GroupNGFilterServiceServer.
+func (s *NGFilterServer) ExportFilters(
+	ctx context.Context,
+	req *pb.ExportFiltersRequest,
+) (*pb.ExportFiltersResponse, error) {
+	format, ok := filterListFormats[req.GetFormat()]
+	if !ok {
+		return nil, invalidArgument("format must be specified")
+	}
+
+	buf := bytes.Buffer{}
+	if err := s.transfer.Export(ctx, req.GetGroupId(), format, &buf); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	contentType := "text/csv"
+	if format == ngfilter.FormatJSON {
+		contentType = "application/json"
+	}
+	return &pb.ExportFiltersResponse{Data: buf.Bytes(), ContentType: contentType}, nil
+}
+
+// TestFilter implements pb.GroupNGFilterServiceServer.
+func (s *NGFilterServer) TestFilter(ctx context.Context, req *pb.TestFilterRequest) (*pb.TestFilterResponse, error) {
+	input := req.GetFilter()
+	if input.GetPattern() == "" {
+		return nil, invalidArgument("pattern is required")
+	}
+	if len(req.GetSamples()) > maxTestSamples {
+		return nil, invalidArgument("too many samples")
+	}
+	for _, sample := range req.GetSamples() {
+		if len(sample) > maxTestSampleLength {
+			return nil, invalidArgument("sample too long")
+		}
+	}
+
+	result, err := s.validator.DryRun(model.GroupNGFilter{
+		GroupID: input.GetGroupId(),
+		Title:   input.GetTitle(),
+		Pattern: input.GetPattern(),
+		Flags:   input.GetFlags(),
+		Action:  toNGFilterAction(input.GetAction()),
+	}, req.GetSamples())
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	res := &pb.TestFilterResponse{
+		Cost:        durationpb.New(result.Cost),
+		Samples:     make([]*pb.SampleResult, len(result.Samples)),
+		ProgramSize: int64(result.ProgramSize),
+	}
+	for i, sample := range result.Samples {
+		spans := make([]*pb.MatchSpan, len(sample.Spans))
+		for j, span := range sample.Spans {
+			spans[j] = &pb.MatchSpan{
+				Start: int32(span.Start),
+				End:   int32(span.End),
+				Text:  sample.Text[span.Start:span.End],
+			}
+		}
+		res.Samples[i] = &pb.SampleResult{
+			Text:   sample.Text,
+			Spans:  spans,
+			Masked: sample.Masked,
+		}
+	}
+	return res, nil
+}
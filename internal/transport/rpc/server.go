@@ -0,0 +1,83 @@
+package rpc
+
+import (
+	"context"
+	"net"
+
+	"github.com/noxhalley/funken/config"
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"google.golang.org/grpc"
+)
+
+// Service registers a gRPC service implementation on the server.
+type Service interface {
+	Register(s *grpc.Server)
+}
+
+type Server struct {
+	logger *log.Logger
+	addr   string
+	srv    *grpc.Server
+}
+
+func NewServer(cfg *config.Config, services []Service) *Server {
+	logger := log.With("service", "grpc_server")
+
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			unaryLogContextInterceptor(logger),
+			unaryRecoveryInterceptor(logger),
+		),
+		grpc.ChainStreamInterceptor(
+			streamLogContextInterceptor(logger),
+			streamRecoveryInterceptor(logger),
+		),
+	)
+	for _, svc := range services {
+		svc.Register(srv)
+	}
+
+	return &Server{
+		logger: logger,
+		addr:   net.JoinHostPort("", cfg.GRPC.Port),
+		srv:    srv,
+	}
+}
+
+// Start binds the listener synchronously so that a busy port fails the
+// fx start hook, then serves in the background.
+func (s *Server) Start(ctx context.Context) error {
+	ln, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		s.logger.Error(ctx, "failed to listen", "addr", s.addr, "error", err)
+		return err
+	}
+
+	s.logger.Info(ctx, "gRPC server listening", "addr", ln.Addr().String())
+	go func() {
+		if err := s.srv.Serve(ln); err != nil {
+			s.logger.Error(context.Background(), "gRPC server stopped unexpectedly", "error", err)
+		}
+	}()
+	return nil
+}
+
+// Stop drains in-flight RPCs and falls back to a hard stop when ctx
+// expires, since open WatchGroup streams would otherwise block forever.
+func (s *Server) Stop(ctx context.Context) error {
+	s.logger.Info(ctx, "Shutting down gRPC server")
+
+	stopped := make(chan struct{})
+	go func() {
+		s.srv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.srv.Stop()
+		return ctx.Err()
+	}
+}
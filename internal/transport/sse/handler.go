@@ -0,0 +1,217 @@
+package sse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/noxhalley/funken/config"
+	"github.com/noxhalley/funken/internal/auth"
+	"github.com/noxhalley/funken/internal/event"
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/infrastructure/pubsub"
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/service"
+	"github.com/noxhalley/funken/internal/transport/rest"
+)
+
+const lastEventIDHeader = "Last-Event-ID"
+
+// Handler streams a group's events, or a member's notifications, as
+// Server-Sent Events. Each event id is the JetStream stream sequence, so a
+// reconnecting EventSource resumes exactly where it left off through
+// Last-Event-ID.
+type Handler struct {
+	logger            *log.Logger
+	authn             auth.Authenticator
+	memberGroupRepo   repository.MemberGroupRepository
+	watcher           pubsub.Watcher
+	sendBuffer        int
+	heartbeatInterval time.Duration
+
+	// closed ends every stream, which graceful shutdown would otherwise
+	// wait for.
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func NewHandler(
+	cfg *config.Config,
+	authn auth.Authenticator,
+	memberGroupRepo repository.MemberGroupRepository,
+	watcher pubsub.Watcher,
+) *Handler {
+	return &Handler{
+		logger:            log.With("service", "sse_handler"),
+		authn:             authn,
+		memberGroupRepo:   memberGroupRepo,
+		watcher:           watcher,
+		sendBuffer:        cfg.SSE.SendBuffer,
+		heartbeatInterval: time.Duration(cfg.SSE.HeartbeatInterval) * time.Millisecond,
+		closed:            make(chan struct{}),
+	}
+}
+
+// CloseStreams implements rest.Streamer.
+func (h *Handler) CloseStreams() {
+	h.closeOnce.Do(func() { close(h.closed) })
+}
+
+// Register implements rest.Handler.
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /sse/groups/{id}", rest.RequireMember(h.authn, h.streamGroup))
+	mux.HandleFunc("GET /sse/groups/{id}/threads/{threadID}", rest.RequireMember(h.authn, h.streamGroup))
+	mux.HandleFunc("GET /sse/members/me", rest.RequireMember(h.authn, h.streamMember))
+}
+
+// streamGroup follows a whole group, or only one of its threads when the
+// path names one.
+func (h *Handler) streamGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	groupID := r.PathValue("id")
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	subject := event.GroupSubject(groupID)
+	if threadID := r.PathValue("threadID"); threadID != "" {
+		subject = event.ThreadSubject(groupID, threadID)
+	}
+
+	isMember, err := h.memberGroupRepo.IsMember(ctx, groupID, memberID)
+	if err != nil {
+		h.logger.Error(ctx, "failed to check membership", "error", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if !isMember {
+		http.Error(w, service.ErrNotMember.Error(), http.StatusForbidden)
+		return
+	}
+
+	ctx = log.AddLogValToCtx(ctx, "group_id", groupID)
+	h.stream(w, r.WithContext(ctx), event.GroupStream, subject)
+}
+
+// streamMember follows the notifications of the caller, such as mentions,
+// from all their groups.
+func (h *Handler) streamMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	ctx = log.AddLogValToCtx(ctx, "member_id", memberID)
+	h.stream(w, r.WithContext(ctx), event.MemberStream, event.MemberSubject(memberID))
+}
+
+func (h *Handler) stream(w http.ResponseWriter, r *http.Request, stream string, subject string) {
+	startSeq, err := resumeSequence(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	// the server-wide write timeout would cut every stream short
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		h.logger.Error(r.Context(), "streaming is not supported by the response writer", "error", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// The watch callback must not block, and must not touch w concurrently
+	// with the heartbeat, so events go through a buffered channel. A full
+	// buffer ends the stream; the client reconnects with Last-Event-ID and
+	// loses nothing.
+	events := make(chan pubsub.WatchedMsg, h.sendBuffer)
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- h.watcher.Watch(ctx, pubsub.WatchParams{
+			Stream:         stream,
+			FilterSubjects: []string{subject},
+			StartSeq:       startSeq,
+		}, func(msg pubsub.WatchedMsg) {
+			select {
+			case events <- msg:
+			default:
+				cancel()
+			}
+		})
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(h.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.closed:
+			return
+		case err := <-watchErr:
+			if ctx.Err() == nil {
+				h.logger.Warn(ctx, "event stream interrupted", "error", err)
+			}
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		case msg := <-events:
+			if err := writeEvent(w, msg); err != nil {
+				return
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, msg pubsub.WatchedMsg) error {
+	head := struct {
+		Type event.Type `json:"type"`
+	}{}
+	_ = json.Unmarshal(msg.Data, &head)
+
+	if head.Type != "" {
+		if _, err := fmt.Fprintf(w, "event: %s\n", head.Type); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "id: %d\ndata: %s\n\n", msg.Sequence, msg.Data)
+	return err
+}
+
+// resumeSequence maps Last-Event-ID to the first stream sequence to replay.
+// EventSource can only send the header on reconnects, so a query parameter
+// is accepted for the initial request as well.
+func resumeSequence(r *http.Request) (uint64, error) {
+	raw := r.Header.Get(lastEventIDHeader)
+	if raw == "" {
+		raw = r.URL.Query().Get("last_event_id")
+	}
+	if raw == "" {
+		return 0, nil
+	}
+
+	seq, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", lastEventIDHeader, raw)
+	}
+	return seq + 1, nil
+}
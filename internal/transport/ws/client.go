@@ -0,0 +1,127 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+)
+
+type clientOptions struct {
+	sendBuffer     int
+	maxMessageSize int64
+	writeTimeout   time.Duration
+	pongTimeout    time.Duration
+}
+
+// client owns one socket. Only writePump writes data frames; everything
+// else hands bytes over through send so a slow socket never blocks the
+// group feed.
+type client struct {
+	logger *log.Logger
+	conn   *websocket.Conn
+	opts   clientOptions
+
+	send      chan []byte
+	done      chan struct{}
+	closeOnce sync.Once
+	closeMsg  []byte
+}
+
+func newClient(logger *log.Logger, conn *websocket.Conn, opts clientOptions) *client {
+	return &client{
+		logger: logger,
+		conn:   conn,
+		opts:   opts,
+		send:   make(chan []byte, opts.sendBuffer),
+		done:   make(chan struct{}),
+	}
+}
+
+// enqueue never blocks. A client whose buffer is full is disconnected
+// instead of slowing down everyone else in the group.
+func (c *client) enqueue(data []byte) {
+	select {
+	case <-c.done:
+	case c.send <- data:
+	default:
+		c.close(websocket.CloseTryAgainLater, "client too slow")
+	}
+}
+
+func (c *client) reply(frame replyFrame) {
+	data, err := json.Marshal(frame)
+	if err != nil {
+		c.logger.Error(context.Background(), "failed to marshal reply", "error", err)
+		return
+	}
+	c.enqueue(data)
+}
+
+func (c *client) close(code int, reason string) {
+	c.closeOnce.Do(func() {
+		c.closeMsg = websocket.FormatCloseMessage(code, reason)
+		close(c.done)
+	})
+}
+
+func (c *client) writePump() {
+	ticker := time.NewTicker(c.opts.pongTimeout * 9 / 10)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case data := <-c.send:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(c.opts.writeTimeout))
+			if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				c.close(websocket.CloseAbnormalClosure, "")
+				return
+			}
+		case <-ticker.C:
+			deadline := time.Now().Add(c.opts.writeTimeout)
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, deadline); err != nil {
+				c.close(websocket.CloseAbnormalClosure, "")
+				return
+			}
+		case <-c.done:
+			deadline := time.Now().Add(c.opts.writeTimeout)
+			_ = c.conn.WriteControl(websocket.CloseMessage, c.closeMsg, deadline)
+			return
+		}
+	}
+}
+
+// readPump blocks until the socket fails or is closed and hands every
+// inbound frame to dispatch.
+func (c *client) readPump(ctx context.Context, dispatch func(ctx context.Context, frame inboundFrame)) {
+	defer c.close(websocket.CloseNormalClosure, "")
+
+	c.conn.SetReadLimit(c.opts.maxMessageSize)
+	_ = c.conn.SetReadDeadline(time.Now().Add(c.opts.pongTimeout))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(c.opts.pongTimeout))
+	})
+
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				c.logger.Debug(ctx, "websocket closed unexpectedly", "error", err)
+			}
+			return
+		}
+
+		frame := inboundFrame{}
+		if err := json.Unmarshal(data, &frame); err != nil {
+			c.reply(replyFrame{Type: frameError, Error: "malformed frame"})
+			continue
+		}
+		dispatch(ctx, frame)
+	}
+}
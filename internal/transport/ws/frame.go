@@ -0,0 +1,55 @@
+package ws
+
+import "encoding/json"
+
+const (
+	frameSendMessage     = "message.send"
+	frameMessageSent     = "message.sent"
+	frameEditMessage     = "message.edit"
+	frameMessageEdited   = "message.edited"
+	frameAddReaction     = "reaction.add"
+	frameReactionAdded   = "reaction.added"
+	frameRemoveReaction  = "reaction.remove"
+	frameReactionRemoved = "reaction.removed"
+	frameMarkRead        = "read.mark"
+	frameReadMarked      = "read.marked"
+	frameError           = "error"
+)
+
+// inboundFrame is what clients write on the socket. Ref is echoed back on
+// the matching reply so clients can correlate acks and errors.
+type inboundFrame struct {
+	Type string          `json:"type"`
+	Ref  string          `json:"ref,omitempty"`
+	Data json.RawMessage `json:"data"`
+}
+
+type sendMessageData struct {
+	Message       string   `json:"message"`
+	Mentions      []string `json:"mentions"`
+	Priority      bool     `json:"priority"`
+	Nickname      string   `json:"nickname"`
+	ParentID      string   `json:"parent_id"`
+	AttachmentIDs []string `json:"attachment_ids"`
+}
+
+type editMessageData struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+}
+
+type reactionData struct {
+	MessageID string `json:"message_id"`
+	Emoji     string `json:"emoji"`
+}
+
+type markReadData struct {
+	MessageID string `json:"message_id"`
+}
+
+type replyFrame struct {
+	Type  string      `json:"type"`
+	Ref   string      `json:"ref,omitempty"`
+	Data  interface{} `json:"data,omitempty"`
+	Error string      `json:"error,omitempty"`
+}
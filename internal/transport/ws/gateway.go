@@ -0,0 +1,230 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/noxhalley/funken/config"
+	"github.com/noxhalley/funken/internal/auth"
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/infrastructure/pubsub"
+	"github.com/noxhalley/funken/internal/infrastructure/repository"
+	"github.com/noxhalley/funken/internal/service"
+	"github.com/noxhalley/funken/internal/transport/rest"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Gateway bridges a group's JetStream subjects, its threads included, to
+// WebSocket clients and routes frames sent by clients through
+// MessageService.
+type Gateway struct {
+	logger          *log.Logger
+	authn           auth.Authenticator
+	memberGroupRepo repository.MemberGroupRepository
+	messageSvc      service.MessageService
+	hub             *hub
+	upgrader        websocket.Upgrader
+	clientOpts      clientOptions
+}
+
+func NewGateway(
+	cfg *config.Config,
+	authn auth.Authenticator,
+	memberGroupRepo repository.MemberGroupRepository,
+	messageSvc service.MessageService,
+	watcher pubsub.Watcher,
+) *Gateway {
+	logger := log.With("service", "websocket_gateway")
+
+	upgrader := websocket.Upgrader{
+		HandshakeTimeout: time.Duration(cfg.WS.WriteTimeout) * time.Millisecond,
+	}
+	if origins := cfg.WS.AllowedOrigins; len(origins) > 0 {
+		upgrader.CheckOrigin = func(r *http.Request) bool {
+			return slices.Contains(origins, "*") || slices.Contains(origins, r.Header.Get("Origin"))
+		}
+	}
+
+	return &Gateway{
+		logger:          logger,
+		authn:           authn,
+		memberGroupRepo: memberGroupRepo,
+		messageSvc:      messageSvc,
+		hub:             newHub(logger, watcher),
+		upgrader:        upgrader,
+		clientOpts: clientOptions{
+			sendBuffer:     cfg.WS.SendBuffer,
+			maxMessageSize: cfg.WS.MaxMessageSize,
+			writeTimeout:   time.Duration(cfg.WS.WriteTimeout) * time.Millisecond,
+			pongTimeout:    time.Duration(cfg.WS.PongTimeout) * time.Millisecond,
+		},
+	}
+}
+
+// Register implements rest.Handler.
+func (g *Gateway) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /ws/groups/{id}", rest.RequireMember(g.authn, g.serve))
+}
+
+// Close disconnects every socket. Hijacked connections are not tracked by
+// http.Server, so its Shutdown alone would leave them open.
+func (g *Gateway) Close(ctx context.Context) error {
+	g.logger.Info(ctx, "Closing websocket gateway")
+	g.hub.close()
+	return nil
+}
+
+func (g *Gateway) serve(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	groupID := r.PathValue("id")
+	memberID, _ := auth.MemberIDFromCtx(ctx)
+
+	isMember, err := g.memberGroupRepo.IsMember(ctx, groupID, memberID)
+	if err != nil {
+		g.logger.Error(ctx, "failed to check membership", "error", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if !isMember {
+		http.Error(w, service.ErrNotMember.Error(), http.StatusForbidden)
+		return
+	}
+
+	conn, err := g.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader has already replied to the client
+		g.logger.Debug(ctx, "failed to upgrade connection", "error", err)
+		return
+	}
+
+	ctx = log.AddLogValToCtx(ctx, "group_id", groupID)
+	c := newClient(g.logger, conn, g.clientOpts)
+
+	g.hub.join(groupID, c)
+	defer g.hub.leave(groupID, c)
+
+	go c.writePump()
+	c.readPump(ctx, func(ctx context.Context, frame inboundFrame) {
+		g.dispatch(ctx, c, groupID, memberID, rest.ClientIP(r), frame)
+	})
+}
+
+func (g *Gateway) dispatch(
+	ctx context.Context,
+	c *client,
+	groupID string,
+	memberID string,
+	ip string,
+	frame inboundFrame,
+) {
+	switch frame.Type {
+	case frameSendMessage:
+		data := sendMessageData{}
+		if err := json.Unmarshal(frame.Data, &data); err != nil {
+			c.reply(replyFrame{Type: frameError, Ref: frame.Ref, Error: "malformed message"})
+			return
+		}
+
+		msg, err := g.messageSvc.Send(ctx, service.SendMessageInput{
+			GroupID:       groupID,
+			SenderID:      memberID,
+			Message:       data.Message,
+			Mentions:      data.Mentions,
+			Priority:      data.Priority,
+			Nickname:      data.Nickname,
+			IPAddress:     ip,
+			ParentID:      data.ParentID,
+			AttachmentIDs: data.AttachmentIDs,
+		})
+		if err != nil {
+			c.reply(replyFrame{Type: frameError, Ref: frame.Ref, Error: g.clientError(ctx, err)})
+			return
+		}
+		c.reply(replyFrame{Type: frameMessageSent, Ref: frame.Ref, Data: msg})
+	case frameEditMessage:
+		data := editMessageData{}
+		if err := json.Unmarshal(frame.Data, &data); err != nil {
+			c.reply(replyFrame{Type: frameError, Ref: frame.Ref, Error: "malformed message"})
+			return
+		}
+
+		msg, err := g.messageSvc.Edit(ctx, service.EditMessageInput{
+			ID:       data.ID,
+			GroupID:  groupID,
+			EditorID: memberID,
+			Message:  data.Message,
+		})
+		if err != nil {
+			c.reply(replyFrame{Type: frameError, Ref: frame.Ref, Error: g.clientError(ctx, err)})
+			return
+		}
+		c.reply(replyFrame{Type: frameMessageEdited, Ref: frame.Ref, Data: msg})
+	case frameAddReaction, frameRemoveReaction:
+		data := reactionData{}
+		if err := json.Unmarshal(frame.Data, &data); err != nil {
+			c.reply(replyFrame{Type: frameError, Ref: frame.Ref, Error: "malformed reaction"})
+			return
+		}
+
+		input := service.ReactionInput{
+			MessageID: data.MessageID,
+			GroupID:   groupID,
+			MemberID:  memberID,
+			Emoji:     data.Emoji,
+		}
+		react, replyType := g.messageSvc.AddReaction, frameReactionAdded
+		if frame.Type == frameRemoveReaction {
+			react, replyType = g.messageSvc.RemoveReaction, frameReactionRemoved
+		}
+
+		change, err := react(ctx, input)
+		if err != nil {
+			c.reply(replyFrame{Type: frameError, Ref: frame.Ref, Error: g.clientError(ctx, err)})
+			return
+		}
+		c.reply(replyFrame{Type: replyType, Ref: frame.Ref, Data: change})
+	case frameMarkRead:
+		data := markReadData{}
+		if err := json.Unmarshal(frame.Data, &data); err != nil {
+			c.reply(replyFrame{Type: frameError, Ref: frame.Ref, Error: "malformed read marker"})
+			return
+		}
+
+		memberGroup, err := g.messageSvc.MarkRead(ctx, service.MarkReadInput{
+			GroupID:   groupID,
+			MemberID:  memberID,
+			MessageID: data.MessageID,
+		})
+		if err != nil {
+			c.reply(replyFrame{Type: frameError, Ref: frame.Ref, Error: g.clientError(ctx, err)})
+			return
+		}
+		c.reply(replyFrame{Type: frameReadMarked, Ref: frame.Ref, Data: memberGroup})
+	default:
+		c.reply(replyFrame{Type: frameError, Ref: frame.Ref, Error: "unknown frame type"})
+	}
+}
+
+// clientError hides internal failures from the socket while keeping
+// validation and permission errors readable.
+func (g *Gateway) clientError(ctx context.Context, err error) string {
+	switch {
+	case errors.Is(err, service.ErrInvalidInput),
+		errors.Is(err, service.ErrNGFilterMatched),
+		errors.Is(err, service.ErrNotMember),
+		errors.Is(err, service.ErrNotSender),
+		errors.Is(err, service.ErrGroupLocked),
+		errors.Is(err, service.ErrEditConflict):
+		return err.Error()
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return "not found"
+	default:
+		g.logger.Error(ctx, "failed to handle frame", "error", err)
+		return http.StatusText(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,133 @@
+package ws
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/noxhalley/funken/internal/event"
+	"github.com/noxhalley/funken/internal/infrastructure/log"
+	"github.com/noxhalley/funken/internal/infrastructure/pubsub"
+)
+
+const feedRetryDelay = 2 * time.Second
+
+// hub keeps a single JetStream watch per group, shared by every socket of
+// that group in this process, and drops it when the last socket leaves.
+type hub struct {
+	logger  *log.Logger
+	watcher pubsub.Watcher
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu    sync.Mutex
+	feeds map[string]*feed
+}
+
+type feed struct {
+	cancel context.CancelFunc
+
+	mu      sync.RWMutex
+	clients map[*client]struct{}
+}
+
+func newHub(logger *log.Logger, watcher pubsub.Watcher) *hub {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &hub{
+		logger:  logger,
+		watcher: watcher,
+		ctx:     ctx,
+		cancel:  cancel,
+		feeds:   make(map[string]*feed),
+	}
+}
+
+func (h *hub) join(groupID string, c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	f, ok := h.feeds[groupID]
+	if !ok {
+		ctx, cancel := context.WithCancel(h.ctx)
+		f = &feed{
+			cancel:  cancel,
+			clients: make(map[*client]struct{}),
+		}
+		h.feeds[groupID] = f
+		go h.run(ctx, groupID, f)
+	}
+
+	f.mu.Lock()
+	f.clients[c] = struct{}{}
+	f.mu.Unlock()
+}
+
+func (h *hub) leave(groupID string, c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	f, ok := h.feeds[groupID]
+	if !ok {
+		return
+	}
+
+	f.mu.Lock()
+	delete(f.clients, c)
+	empty := len(f.clients) == 0
+	f.mu.Unlock()
+
+	if empty {
+		f.cancel()
+		delete(h.feeds, groupID)
+	}
+}
+
+func (h *hub) run(ctx context.Context, groupID string, f *feed) {
+	ctx = log.AddLogValToCtx(ctx, "group_id", groupID)
+	// sockets follow a whole group, so replies are sent along with the
+	// rest and clients tell them apart by their thread_id
+	params := pubsub.WatchParams{
+		Stream:         event.GroupStream,
+		FilterSubjects: []string{event.GroupSubject(groupID), event.ThreadsSubject(groupID)},
+	}
+
+	for {
+		err := h.watcher.Watch(ctx, params, f.broadcast)
+		if ctx.Err() != nil {
+			return
+		}
+
+		h.logger.Warn(ctx, "group feed interrupted, retrying", "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(feedRetryDelay):
+		}
+	}
+}
+
+func (f *feed) broadcast(msg pubsub.WatchedMsg) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	for c := range f.clients {
+		c.enqueue(msg.Data)
+	}
+}
+
+func (h *hub) close() {
+	h.cancel()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, f := range h.feeds {
+		f.mu.RLock()
+		for c := range f.clients {
+			c.close(websocket.CloseGoingAway, "server shutting down")
+		}
+		f.mu.RUnlock()
+	}
+}
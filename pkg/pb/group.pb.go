@@ -0,0 +1,1125 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: funken/v1/group.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GroupStatus int32
+
+const (
+	GroupStatus_GROUP_STATUS_UNSPECIFIED GroupStatus = 0
+	GroupStatus_GROUP_STATUS_ACTIVE      GroupStatus = 1
+	GroupStatus_GROUP_STATUS_LOCKED      GroupStatus = 2
+)
+
+// Enum value maps for GroupStatus.
+var (
+	GroupStatus_name = map[int32]string{
+		0: "GROUP_STATUS_UNSPECIFIED",
+		1: "GROUP_STATUS_ACTIVE",
+		2: "GROUP_STATUS_LOCKED",
+	}
+	GroupStatus_value = map[string]int32{
+		"GROUP_STATUS_UNSPECIFIED": 0,
+		"GROUP_STATUS_ACTIVE":      1,
+		"GROUP_STATUS_LOCKED":      2,
+	}
+)
+
+func (x GroupStatus) Enum() *GroupStatus {
+	p := new(GroupStatus)
+	*p = x
+	return p
+}
+
+func (x GroupStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_funken_v1_group_proto_enumTypes[0].Descriptor()
+}
+
+func (GroupStatus) Type() protoreflect.EnumType {
+	return &file_funken_v1_group_proto_enumTypes[0]
+}
+
+func (x GroupStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupStatus.Descriptor instead.
+func (GroupStatus) EnumDescriptor() ([]byte, []int) {
+	return file_funken_v1_group_proto_rawDescGZIP(), []int{0}
+}
+
+type RetentionKind int32
+
+const (
+	RetentionKind_RETENTION_KIND_UNSPECIFIED RetentionKind = 0
+	RetentionKind_RETENTION_KIND_FOREVER     RetentionKind = 1
+	RetentionKind_RETENTION_KIND_DAYS        RetentionKind = 2
+	RetentionKind_RETENTION_KIND_MESSAGES    RetentionKind = 3
+)
+
+// Enum value maps for RetentionKind.
+var (
+	RetentionKind_name = map[int32]string{
+		0: "RETENTION_KIND_UNSPECIFIED",
+		1: "RETENTION_KIND_FOREVER",
+		2: "RETENTION_KIND_DAYS",
+		3: "RETENTION_KIND_MESSAGES",
+	}
+	RetentionKind_value = map[string]int32{
+		"RETENTION_KIND_UNSPECIFIED": 0,
+		"RETENTION_KIND_FOREVER":     1,
+		"RETENTION_KIND_DAYS":        2,
+		"RETENTION_KIND_MESSAGES":    3,
+	}
+)
+
+func (x RetentionKind) Enum() *RetentionKind {
+	p := new(RetentionKind)
+	*p = x
+	return p
+}
+
+func (x RetentionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RetentionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_funken_v1_group_proto_enumTypes[1].Descriptor()
+}
+
+func (RetentionKind) Type() protoreflect.EnumType {
+	return &file_funken_v1_group_proto_enumTypes[1]
+}
+
+func (x RetentionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RetentionKind.Descriptor instead.
+func (RetentionKind) EnumDescriptor() ([]byte, []int) {
+	return file_funken_v1_group_proto_rawDescGZIP(), []int{1}
+}
+
+// Retention bounds how long a group keeps its messages: value days, or its
+// value most recent messages.
+type Retention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          RetentionKind          `protobuf:"varint,1,opt,name=kind,proto3,enum=funken.v1.RetentionKind" json:"kind,omitempty"`
+	Value         int64                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Retention) Reset() {
+	*x = Retention{}
+	mi := &file_funken_v1_group_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Retention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Retention) ProtoMessage() {}
+
+func (x *Retention) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_group_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Retention.ProtoReflect.Descriptor instead.
+func (*Retention) Descriptor() ([]byte, []int) {
+	return file_funken_v1_group_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Retention) GetKind() RetentionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return RetentionKind_RETENTION_KIND_UNSPECIFIED
+}
+
+func (x *Retention) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// AttachmentPolicy restricts the files uploaded to a group. max_size is in
+// bytes, allowed_types are MIME types, "image/*" standing for every image
+// type. Zero fields fall back to the server defaults.
+type AttachmentPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxSize       int64                  `protobuf:"varint,1,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	AllowedTypes  []string               `protobuf:"bytes,2,rep,name=allowed_types,json=allowedTypes,proto3" json:"allowed_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentPolicy) Reset() {
+	*x = AttachmentPolicy{}
+	mi := &file_funken_v1_group_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentPolicy) ProtoMessage() {}
+
+func (x *AttachmentPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_group_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentPolicy.ProtoReflect.Descriptor instead.
+func (*AttachmentPolicy) Descriptor() ([]byte, []int) {
+	return file_funken_v1_group_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttachmentPolicy) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *AttachmentPolicy) GetAllowedTypes() []string {
+	if x != nil {
+		return x.AllowedTypes
+	}
+	return nil
+}
+
+type Group struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Meta         *structpb.Struct       `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+	Status       GroupStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=funken.v1.GroupStatus" json:"status,omitempty"`
+	MemberCount  *int64                 `protobuf:"varint,6,opt,name=member_count,json=memberCount,proto3,oneof" json:"member_count,omitempty"`
+	MessageCount int64                  `protobuf:"varint,7,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`
+	// ng_filter_opt_outs lists the global NG filters not applied to the group.
+	NgFilterOptOuts []string `protobuf:"bytes,8,rep,name=ng_filter_opt_outs,json=ngFilterOptOuts,proto3" json:"ng_filter_opt_outs,omitempty"`
+	// retention is unset for groups keeping their messages forever.
+	Retention        *Retention        `protobuf:"bytes,9,opt,name=retention,proto3" json:"retention,omitempty"`
+	AttachmentPolicy *AttachmentPolicy `protobuf:"bytes,10,opt,name=attachment_policy,json=attachmentPolicy,proto3" json:"attachment_policy,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_funken_v1_group_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_group_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_funken_v1_group_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Group) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Group) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Group) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Group) GetMeta() *structpb.Struct {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *Group) GetStatus() GroupStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GroupStatus_GROUP_STATUS_UNSPECIFIED
+}
+
+func (x *Group) GetMemberCount() int64 {
+	if x != nil && x.MemberCount != nil {
+		return *x.MemberCount
+	}
+	return 0
+}
+
+func (x *Group) GetMessageCount() int64 {
+	if x != nil {
+		return x.MessageCount
+	}
+	return 0
+}
+
+func (x *Group) GetNgFilterOptOuts() []string {
+	if x != nil {
+		return x.NgFilterOptOuts
+	}
+	return nil
+}
+
+func (x *Group) GetRetention() *Retention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *Group) GetAttachmentPolicy() *AttachmentPolicy {
+	if x != nil {
+		return x.AttachmentPolicy
+	}
+	return nil
+}
+
+// GroupEvent is an event published on the group's JetStream subject.
+type GroupEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sequence is the JetStream stream sequence, usable as start_sequence
+	// to resume a watch.
+	Sequence   uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type       string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	GroupId    string                 `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Data       *structpb.Struct       `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// thread_id is set on events about replies.
+	ThreadId      string `protobuf:"bytes,6,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupEvent) Reset() {
+	*x = GroupEvent{}
+	mi := &file_funken_v1_group_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupEvent) ProtoMessage() {}
+
+func (x *GroupEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_group_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupEvent.ProtoReflect.Descriptor instead.
+func (*GroupEvent) Descriptor() ([]byte, []int) {
+	return file_funken_v1_group_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GroupEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *GroupEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GroupEvent) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupEvent) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GroupEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *GroupEvent) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+type CreateGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is generated when empty.
+	Id               string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta             *structpb.Struct  `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Status           GroupStatus       `protobuf:"varint,3,opt,name=status,proto3,enum=funken.v1.GroupStatus" json:"status,omitempty"`
+	Retention        *Retention        `protobuf:"bytes,4,opt,name=retention,proto3" json:"retention,omitempty"`
+	AttachmentPolicy *AttachmentPolicy `protobuf:"bytes,5,opt,name=attachment_policy,json=attachmentPolicy,proto3" json:"attachment_policy,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_funken_v1_group_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_group_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_group_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetMeta() *structpb.Struct {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateGroupRequest) GetStatus() GroupStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GroupStatus_GROUP_STATUS_UNSPECIFIED
+}
+
+func (x *CreateGroupRequest) GetRetention() *Retention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *CreateGroupRequest) GetAttachmentPolicy() *AttachmentPolicy {
+	if x != nil {
+		return x.AttachmentPolicy
+	}
+	return nil
+}
+
+type GetGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	mi := &file_funken_v1_group_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_group_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_group_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        GroupStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=funken.v1.GroupStatus" json:"status,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsRequest) Reset() {
+	*x = ListGroupsRequest{}
+	mi := &file_funken_v1_group_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsRequest) ProtoMessage() {}
+
+func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_group_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_group_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListGroupsRequest) GetStatus() GroupStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GroupStatus_GROUP_STATUS_UNSPECIFIED
+}
+
+func (x *ListGroupsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListGroupsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*Group               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsResponse) Reset() {
+	*x = ListGroupsResponse{}
+	mi := &file_funken_v1_group_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsResponse) ProtoMessage() {}
+
+func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_group_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_funken_v1_group_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListGroupsResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type UpdateGroupRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta   *structpb.Struct       `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Status GroupStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=funken.v1.GroupStatus" json:"status,omitempty"`
+	// retention replaces the current one when set.
+	Retention *Retention `protobuf:"bytes,4,opt,name=retention,proto3" json:"retention,omitempty"`
+	// attachment_policy replaces the current one when set.
+	AttachmentPolicy *AttachmentPolicy `protobuf:"bytes,5,opt,name=attachment_policy,json=attachmentPolicy,proto3" json:"attachment_policy,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
+	mi := &file_funken_v1_group_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRequest) ProtoMessage() {}
+
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_group_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_group_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetMeta() *structpb.Struct {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpdateGroupRequest) GetStatus() GroupStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GroupStatus_GROUP_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateGroupRequest) GetRetention() *Retention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *UpdateGroupRequest) GetAttachmentPolicy() *AttachmentPolicy {
+	if x != nil {
+		return x.AttachmentPolicy
+	}
+	return nil
+}
+
+type DeleteGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupRequest) Reset() {
+	*x = DeleteGroupRequest{}
+	mi := &file_funken_v1_group_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRequest) ProtoMessage() {}
+
+func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_group_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_group_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CheckGroupExistsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckGroupExistsRequest) Reset() {
+	*x = CheckGroupExistsRequest{}
+	mi := &file_funken_v1_group_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckGroupExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckGroupExistsRequest) ProtoMessage() {}
+
+func (x *CheckGroupExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_group_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckGroupExistsRequest.ProtoReflect.Descriptor instead.
+func (*CheckGroupExistsRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_group_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CheckGroupExistsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CheckGroupExistsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exists        bool                   `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckGroupExistsResponse) Reset() {
+	*x = CheckGroupExistsResponse{}
+	mi := &file_funken_v1_group_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckGroupExistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckGroupExistsResponse) ProtoMessage() {}
+
+func (x *CheckGroupExistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_group_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckGroupExistsResponse.ProtoReflect.Descriptor instead.
+func (*CheckGroupExistsResponse) Descriptor() ([]byte, []int) {
+	return file_funken_v1_group_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CheckGroupExistsResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+type WatchGroupRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// start_sequence replays the stream from the given sequence, inclusive.
+	// Zero only delivers events published after the call.
+	StartSequence uint64 `protobuf:"varint,2,opt,name=start_sequence,json=startSequence,proto3" json:"start_sequence,omitempty"`
+	// thread_id follows the replies of that thread instead of the group.
+	ThreadId      string `protobuf:"bytes,3,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchGroupRequest) Reset() {
+	*x = WatchGroupRequest{}
+	mi := &file_funken_v1_group_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchGroupRequest) ProtoMessage() {}
+
+func (x *WatchGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_group_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchGroupRequest.ProtoReflect.Descriptor instead.
+func (*WatchGroupRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_group_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *WatchGroupRequest) GetStartSequence() uint64 {
+	if x != nil {
+		return x.StartSequence
+	}
+	return 0
+}
+
+func (x *WatchGroupRequest) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+var File_funken_v1_group_proto protoreflect.FileDescriptor
+
+const file_funken_v1_group_proto_rawDesc = "" +
+	"\n" +
+	"\x15funken/v1/group.proto\x12\tfunken.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"O\n" +
+	"\tRetention\x12,\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x18.funken.v1.RetentionKindR\x04kind\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value\"R\n" +
+	"\x10AttachmentPolicy\x12\x19\n" +
+	"\bmax_size\x18\x01 \x01(\x03R\amaxSize\x12#\n" +
+	"\rallowed_types\x18\x02 \x03(\tR\fallowedTypes\"\xf3\x03\n" +
+	"\x05Group\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12+\n" +
+	"\x04meta\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x04meta\x12.\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x16.funken.v1.GroupStatusR\x06status\x12&\n" +
+	"\fmember_count\x18\x06 \x01(\x03H\x00R\vmemberCount\x88\x01\x01\x12#\n" +
+	"\rmessage_count\x18\a \x01(\x03R\fmessageCount\x12+\n" +
+	"\x12ng_filter_opt_outs\x18\b \x03(\tR\x0fngFilterOptOuts\x122\n" +
+	"\tretention\x18\t \x01(\v2\x14.funken.v1.RetentionR\tretention\x12H\n" +
+	"\x11attachment_policy\x18\n" +
+	" \x01(\v2\x1b.funken.v1.AttachmentPolicyR\x10attachmentPolicyB\x0f\n" +
+	"\r_member_count\"\xde\x01\n" +
+	"\n" +
+	"GroupEvent\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x19\n" +
+	"\bgroup_id\x18\x03 \x01(\tR\agroupId\x12+\n" +
+	"\x04data\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x04data\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x1b\n" +
+	"\tthread_id\x18\x06 \x01(\tR\bthreadId\"\xff\x01\n" +
+	"\x12CreateGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x04meta\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x04meta\x12.\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.funken.v1.GroupStatusR\x06status\x122\n" +
+	"\tretention\x18\x04 \x01(\v2\x14.funken.v1.RetentionR\tretention\x12H\n" +
+	"\x11attachment_policy\x18\x05 \x01(\v2\x1b.funken.v1.AttachmentPolicyR\x10attachmentPolicy\"!\n" +
+	"\x0fGetGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"q\n" +
+	"\x11ListGroupsRequest\x12.\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x16.funken.v1.GroupStatusR\x06status\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset\">\n" +
+	"\x12ListGroupsResponse\x12(\n" +
+	"\x06groups\x18\x01 \x03(\v2\x10.funken.v1.GroupR\x06groups\"\xff\x01\n" +
+	"\x12UpdateGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x04meta\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x04meta\x12.\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.funken.v1.GroupStatusR\x06status\x122\n" +
+	"\tretention\x18\x04 \x01(\v2\x14.funken.v1.RetentionR\tretention\x12H\n" +
+	"\x11attachment_policy\x18\x05 \x01(\v2\x1b.funken.v1.AttachmentPolicyR\x10attachmentPolicy\"$\n" +
+	"\x12DeleteGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\")\n" +
+	"\x17CheckGroupExistsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
+	"\x18CheckGroupExistsResponse\x12\x16\n" +
+	"\x06exists\x18\x01 \x01(\bR\x06exists\"r\n" +
+	"\x11WatchGroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12%\n" +
+	"\x0estart_sequence\x18\x02 \x01(\x04R\rstartSequence\x12\x1b\n" +
+	"\tthread_id\x18\x03 \x01(\tR\bthreadId*]\n" +
+	"\vGroupStatus\x12\x1c\n" +
+	"\x18GROUP_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13GROUP_STATUS_ACTIVE\x10\x01\x12\x17\n" +
+	"\x13GROUP_STATUS_LOCKED\x10\x02*\x81\x01\n" +
+	"\rRetentionKind\x12\x1e\n" +
+	"\x1aRETENTION_KIND_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16RETENTION_KIND_FOREVER\x10\x01\x12\x17\n" +
+	"\x13RETENTION_KIND_DAYS\x10\x02\x12\x1b\n" +
+	"\x17RETENTION_KIND_MESSAGES\x10\x032\xfb\x03\n" +
+	"\fGroupService\x12>\n" +
+	"\vCreateGroup\x12\x1d.funken.v1.CreateGroupRequest\x1a\x10.funken.v1.Group\x128\n" +
+	"\bGetGroup\x12\x1a.funken.v1.GetGroupRequest\x1a\x10.funken.v1.Group\x12I\n" +
+	"\n" +
+	"ListGroups\x12\x1c.funken.v1.ListGroupsRequest\x1a\x1d.funken.v1.ListGroupsResponse\x12>\n" +
+	"\vUpdateGroup\x12\x1d.funken.v1.UpdateGroupRequest\x1a\x10.funken.v1.Group\x12D\n" +
+	"\vDeleteGroup\x12\x1d.funken.v1.DeleteGroupRequest\x1a\x16.google.protobuf.Empty\x12[\n" +
+	"\x10CheckGroupExists\x12\".funken.v1.CheckGroupExistsRequest\x1a#.funken.v1.CheckGroupExistsResponse\x12C\n" +
+	"\n" +
+	"WatchGroup\x12\x1c.funken.v1.WatchGroupRequest\x1a\x15.funken.v1.GroupEvent0\x01B'Z%github.com/noxhalley/funken/pkg/pb;pbb\x06proto3"
+
+var (
+	file_funken_v1_group_proto_rawDescOnce sync.Once
+	file_funken_v1_group_proto_rawDescData []byte
+)
+
+func file_funken_v1_group_proto_rawDescGZIP() []byte {
+	file_funken_v1_group_proto_rawDescOnce.Do(func() {
+		file_funken_v1_group_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_funken_v1_group_proto_rawDesc), len(file_funken_v1_group_proto_rawDesc)))
+	})
+	return file_funken_v1_group_proto_rawDescData
+}
+
+var file_funken_v1_group_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_funken_v1_group_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_funken_v1_group_proto_goTypes = []any{
+	(GroupStatus)(0),                 // 0: funken.v1.GroupStatus
+	(RetentionKind)(0),               // 1: funken.v1.RetentionKind
+	(*Retention)(nil),                // 2: funken.v1.Retention
+	(*AttachmentPolicy)(nil),         // 3: funken.v1.AttachmentPolicy
+	(*Group)(nil),                    // 4: funken.v1.Group
+	(*GroupEvent)(nil),               // 5: funken.v1.GroupEvent
+	(*CreateGroupRequest)(nil),       // 6: funken.v1.CreateGroupRequest
+	(*GetGroupRequest)(nil),          // 7: funken.v1.GetGroupRequest
+	(*ListGroupsRequest)(nil),        // 8: funken.v1.ListGroupsRequest
+	(*ListGroupsResponse)(nil),       // 9: funken.v1.ListGroupsResponse
+	(*UpdateGroupRequest)(nil),       // 10: funken.v1.UpdateGroupRequest
+	(*DeleteGroupRequest)(nil),       // 11: funken.v1.DeleteGroupRequest
+	(*CheckGroupExistsRequest)(nil),  // 12: funken.v1.CheckGroupExistsRequest
+	(*CheckGroupExistsResponse)(nil), // 13: funken.v1.CheckGroupExistsResponse
+	(*WatchGroupRequest)(nil),        // 14: funken.v1.WatchGroupRequest
+	(*timestamppb.Timestamp)(nil),    // 15: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),          // 16: google.protobuf.Struct
+	(*emptypb.Empty)(nil),            // 17: google.protobuf.Empty
+}
+var file_funken_v1_group_proto_depIdxs = []int32{
+	1,  // 0: funken.v1.Retention.kind:type_name -> funken.v1.RetentionKind
+	15, // 1: funken.v1.Group.created_at:type_name -> google.protobuf.Timestamp
+	15, // 2: funken.v1.Group.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 3: funken.v1.Group.meta:type_name -> google.protobuf.Struct
+	0,  // 4: funken.v1.Group.status:type_name -> funken.v1.GroupStatus
+	2,  // 5: funken.v1.Group.retention:type_name -> funken.v1.Retention
+	3,  // 6: funken.v1.Group.attachment_policy:type_name -> funken.v1.AttachmentPolicy
+	16, // 7: funken.v1.GroupEvent.data:type_name -> google.protobuf.Struct
+	15, // 8: funken.v1.GroupEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	16, // 9: funken.v1.CreateGroupRequest.meta:type_name -> google.protobuf.Struct
+	0,  // 10: funken.v1.CreateGroupRequest.status:type_name -> funken.v1.GroupStatus
+	2,  // 11: funken.v1.CreateGroupRequest.retention:type_name -> funken.v1.Retention
+	3,  // 12: funken.v1.CreateGroupRequest.attachment_policy:type_name -> funken.v1.AttachmentPolicy
+	0,  // 13: funken.v1.ListGroupsRequest.status:type_name -> funken.v1.GroupStatus
+	4,  // 14: funken.v1.ListGroupsResponse.groups:type_name -> funken.v1.Group
+	16, // 15: funken.v1.UpdateGroupRequest.meta:type_name -> google.protobuf.Struct
+	0,  // 16: funken.v1.UpdateGroupRequest.status:type_name -> funken.v1.GroupStatus
+	2,  // 17: funken.v1.UpdateGroupRequest.retention:type_name -> funken.v1.Retention
+	3,  // 18: funken.v1.UpdateGroupRequest.attachment_policy:type_name -> funken.v1.AttachmentPolicy
+	6,  // 19: funken.v1.GroupService.CreateGroup:input_type -> funken.v1.CreateGroupRequest
+	7,  // 20: funken.v1.GroupService.GetGroup:input_type -> funken.v1.GetGroupRequest
+	8,  // 21: funken.v1.GroupService.ListGroups:input_type -> funken.v1.ListGroupsRequest
+	10, // 22: funken.v1.GroupService.UpdateGroup:input_type -> funken.v1.UpdateGroupRequest
+	11, // 23: funken.v1.GroupService.DeleteGroup:input_type -> funken.v1.DeleteGroupRequest
+	12, // 24: funken.v1.GroupService.CheckGroupExists:input_type -> funken.v1.CheckGroupExistsRequest
+	14, // 25: funken.v1.GroupService.WatchGroup:input_type -> funken.v1.WatchGroupRequest
+	4,  // 26: funken.v1.GroupService.CreateGroup:output_type -> funken.v1.Group
+	4,  // 27: funken.v1.GroupService.GetGroup:output_type -> funken.v1.Group
+	9,  // 28: funken.v1.GroupService.ListGroups:output_type -> funken.v1.ListGroupsResponse
+	4,  // 29: funken.v1.GroupService.UpdateGroup:output_type -> funken.v1.Group
+	17, // 30: funken.v1.GroupService.DeleteGroup:output_type -> google.protobuf.Empty
+	13, // 31: funken.v1.GroupService.CheckGroupExists:output_type -> funken.v1.CheckGroupExistsResponse
+	5,  // 32: funken.v1.GroupService.WatchGroup:output_type -> funken.v1.GroupEvent
+	26, // [26:33] is the sub-list for method output_type
+	19, // [19:26] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_funken_v1_group_proto_init() }
+func file_funken_v1_group_proto_init() {
+	if File_funken_v1_group_proto != nil {
+		return
+	}
+	file_funken_v1_group_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_funken_v1_group_proto_rawDesc), len(file_funken_v1_group_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_funken_v1_group_proto_goTypes,
+		DependencyIndexes: file_funken_v1_group_proto_depIdxs,
+		EnumInfos:         file_funken_v1_group_proto_enumTypes,
+		MessageInfos:      file_funken_v1_group_proto_msgTypes,
+	}.Build()
+	File_funken_v1_group_proto = out.File
+	file_funken_v1_group_proto_goTypes = nil
+	file_funken_v1_group_proto_depIdxs = nil
+}
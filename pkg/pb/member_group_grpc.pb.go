@@ -0,0 +1,392 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: funken/v1/member_group.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MemberGroupService_ListMemberIDs_FullMethodName   = "/funken.v1.MemberGroupService/ListMemberIDs"
+	MemberGroupService_CountMembers_FullMethodName    = "/funken.v1.MemberGroupService/CountMembers"
+	MemberGroupService_AddMembers_FullMethodName      = "/funken.v1.MemberGroupService/AddMembers"
+	MemberGroupService_RemoveMembers_FullMethodName   = "/funken.v1.MemberGroupService/RemoveMembers"
+	MemberGroupService_IsMember_FullMethodName        = "/funken.v1.MemberGroupService/IsMember"
+	MemberGroupService_MarkRead_FullMethodName        = "/funken.v1.MemberGroupService/MarkRead"
+	MemberGroupService_GetUnreadCounts_FullMethodName = "/funken.v1.MemberGroupService/GetUnreadCounts"
+	MemberGroupService_SetMute_FullMethodName         = "/funken.v1.MemberGroupService/SetMute"
+)
+
+// MemberGroupServiceClient is the client API for MemberGroupService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MemberGroupServiceClient interface {
+	ListMemberIDs(ctx context.Context, in *ListMemberIDsRequest, opts ...grpc.CallOption) (*ListMemberIDsResponse, error)
+	CountMembers(ctx context.Context, in *CountMembersRequest, opts ...grpc.CallOption) (*CountMembersResponse, error)
+	AddMembers(ctx context.Context, in *AddMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveMembers(ctx context.Context, in *RemoveMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	IsMember(ctx context.Context, in *IsMemberRequest, opts ...grpc.CallOption) (*IsMemberResponse, error)
+	// MarkRead only moves the read marker forward.
+	MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*ReadMarker, error)
+	GetUnreadCounts(ctx context.Context, in *GetUnreadCountsRequest, opts ...grpc.CallOption) (*GetUnreadCountsResponse, error)
+	// SetMute silences mention notifications from the group.
+	SetMute(ctx context.Context, in *SetMuteRequest, opts ...grpc.CallOption) (*MuteSetting, error)
+}
+
+type memberGroupServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMemberGroupServiceClient(cc grpc.ClientConnInterface) MemberGroupServiceClient {
+	return &memberGroupServiceClient{cc}
+}
+
+func (c *memberGroupServiceClient) ListMemberIDs(ctx context.Context, in *ListMemberIDsRequest, opts ...grpc.CallOption) (*ListMemberIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemberIDsResponse)
+	err := c.cc.Invoke(ctx, MemberGroupService_ListMemberIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberGroupServiceClient) CountMembers(ctx context.Context, in *CountMembersRequest, opts ...grpc.CallOption) (*CountMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountMembersResponse)
+	err := c.cc.Invoke(ctx, MemberGroupService_CountMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberGroupServiceClient) AddMembers(ctx context.Context, in *AddMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemberGroupService_AddMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberGroupServiceClient) RemoveMembers(ctx context.Context, in *RemoveMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemberGroupService_RemoveMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberGroupServiceClient) IsMember(ctx context.Context, in *IsMemberRequest, opts ...grpc.CallOption) (*IsMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsMemberResponse)
+	err := c.cc.Invoke(ctx, MemberGroupService_IsMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberGroupServiceClient) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*ReadMarker, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadMarker)
+	err := c.cc.Invoke(ctx, MemberGroupService_MarkRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberGroupServiceClient) GetUnreadCounts(ctx context.Context, in *GetUnreadCountsRequest, opts ...grpc.CallOption) (*GetUnreadCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUnreadCountsResponse)
+	err := c.cc.Invoke(ctx, MemberGroupService_GetUnreadCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberGroupServiceClient) SetMute(ctx context.Context, in *SetMuteRequest, opts ...grpc.CallOption) (*MuteSetting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteSetting)
+	err := c.cc.Invoke(ctx, MemberGroupService_SetMute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MemberGroupServiceServer is the server API for MemberGroupService service.
+// All implementations must embed UnimplementedMemberGroupServiceServer
+// for forward compatibility.
+type MemberGroupServiceServer interface {
+	ListMemberIDs(context.Context, *ListMemberIDsRequest) (*ListMemberIDsResponse, error)
+	CountMembers(context.Context, *CountMembersRequest) (*CountMembersResponse, error)
+	AddMembers(context.Context, *AddMembersRequest) (*emptypb.Empty, error)
+	RemoveMembers(context.Context, *RemoveMembersRequest) (*emptypb.Empty, error)
+	IsMember(context.Context, *IsMemberRequest) (*IsMemberResponse, error)
+	// MarkRead only moves the read marker forward.
+	MarkRead(context.Context, *MarkReadRequest) (*ReadMarker, error)
+	GetUnreadCounts(context.Context, *GetUnreadCountsRequest) (*GetUnreadCountsResponse, error)
+	// SetMute silences mention notifications from the group.
+	SetMute(context.Context, *SetMuteRequest) (*MuteSetting, error)
+	mustEmbedUnimplementedMemberGroupServiceServer()
+}
+
+// UnimplementedMemberGroupServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMemberGroupServiceServer struct{}
+
+func (UnimplementedMemberGroupServiceServer) ListMemberIDs(context.Context, *ListMemberIDsRequest) (*ListMemberIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemberIDs not implemented")
+}
+func (UnimplementedMemberGroupServiceServer) CountMembers(context.Context, *CountMembersRequest) (*CountMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountMembers not implemented")
+}
+func (UnimplementedMemberGroupServiceServer) AddMembers(context.Context, *AddMembersRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMembers not implemented")
+}
+func (UnimplementedMemberGroupServiceServer) RemoveMembers(context.Context, *RemoveMembersRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMembers not implemented")
+}
+func (UnimplementedMemberGroupServiceServer) IsMember(context.Context, *IsMemberRequest) (*IsMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsMember not implemented")
+}
+func (UnimplementedMemberGroupServiceServer) MarkRead(context.Context, *MarkReadRequest) (*ReadMarker, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
+func (UnimplementedMemberGroupServiceServer) GetUnreadCounts(context.Context, *GetUnreadCountsRequest) (*GetUnreadCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadCounts not implemented")
+}
+func (UnimplementedMemberGroupServiceServer) SetMute(context.Context, *SetMuteRequest) (*MuteSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMute not implemented")
+}
+func (UnimplementedMemberGroupServiceServer) mustEmbedUnimplementedMemberGroupServiceServer() {}
+func (UnimplementedMemberGroupServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeMemberGroupServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MemberGroupServiceServer will
+// result in compilation errors.
+type UnsafeMemberGroupServiceServer interface {
+	mustEmbedUnimplementedMemberGroupServiceServer()
+}
+
+func RegisterMemberGroupServiceServer(s grpc.ServiceRegistrar, srv MemberGroupServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMemberGroupServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MemberGroupService_ServiceDesc, srv)
+}
+
+func _MemberGroupService_ListMemberIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberGroupServiceServer).ListMemberIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberGroupService_ListMemberIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberGroupServiceServer).ListMemberIDs(ctx, req.(*ListMemberIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberGroupService_CountMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberGroupServiceServer).CountMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberGroupService_CountMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberGroupServiceServer).CountMembers(ctx, req.(*CountMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberGroupService_AddMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberGroupServiceServer).AddMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberGroupService_AddMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberGroupServiceServer).AddMembers(ctx, req.(*AddMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberGroupService_RemoveMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberGroupServiceServer).RemoveMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberGroupService_RemoveMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberGroupServiceServer).RemoveMembers(ctx, req.(*RemoveMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberGroupService_IsMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberGroupServiceServer).IsMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberGroupService_IsMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberGroupServiceServer).IsMember(ctx, req.(*IsMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberGroupService_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberGroupServiceServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberGroupService_MarkRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberGroupServiceServer).MarkRead(ctx, req.(*MarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberGroupService_GetUnreadCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberGroupServiceServer).GetUnreadCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberGroupService_GetUnreadCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberGroupServiceServer).GetUnreadCounts(ctx, req.(*GetUnreadCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberGroupService_SetMute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberGroupServiceServer).SetMute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberGroupService_SetMute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberGroupServiceServer).SetMute(ctx, req.(*SetMuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MemberGroupService_ServiceDesc is the grpc.ServiceDesc for MemberGroupService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MemberGroupService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "funken.v1.MemberGroupService",
+	HandlerType: (*MemberGroupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMemberIDs",
+			Handler:    _MemberGroupService_ListMemberIDs_Handler,
+		},
+		{
+			MethodName: "CountMembers",
+			Handler:    _MemberGroupService_CountMembers_Handler,
+		},
+		{
+			MethodName: "AddMembers",
+			Handler:    _MemberGroupService_AddMembers_Handler,
+		},
+		{
+			MethodName: "RemoveMembers",
+			Handler:    _MemberGroupService_RemoveMembers_Handler,
+		},
+		{
+			MethodName: "IsMember",
+			Handler:    _MemberGroupService_IsMember_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _MemberGroupService_MarkRead_Handler,
+		},
+		{
+			MethodName: "GetUnreadCounts",
+			Handler:    _MemberGroupService_GetUnreadCounts_Handler,
+		},
+		{
+			MethodName: "SetMute",
+			Handler:    _MemberGroupService_SetMute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "funken/v1/member_group.proto",
+}
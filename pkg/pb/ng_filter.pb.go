@@ -0,0 +1,2308 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: funken/v1/ng_filter.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NGFilterAction is what happens to a message matching a filter. When
+// several filters match, the strongest action applies, block being the
+// strongest and flag the weakest.
+type NGFilterAction int32
+
+const (
+	// unspecified filters block, as they did before actions existed.
+	NGFilterAction_NG_FILTER_ACTION_UNSPECIFIED NGFilterAction = 0
+	NGFilterAction_NG_FILTER_ACTION_FLAG        NGFilterAction = 1
+	NGFilterAction_NG_FILTER_ACTION_MASK        NGFilterAction = 2
+	NGFilterAction_NG_FILTER_ACTION_SHADOW      NGFilterAction = 3
+	NGFilterAction_NG_FILTER_ACTION_BLOCK       NGFilterAction = 4
+)
+
+// Enum value maps for NGFilterAction.
+var (
+	NGFilterAction_name = map[int32]string{
+		0: "NG_FILTER_ACTION_UNSPECIFIED",
+		1: "NG_FILTER_ACTION_FLAG",
+		2: "NG_FILTER_ACTION_MASK",
+		3: "NG_FILTER_ACTION_SHADOW",
+		4: "NG_FILTER_ACTION_BLOCK",
+	}
+	NGFilterAction_value = map[string]int32{
+		"NG_FILTER_ACTION_UNSPECIFIED": 0,
+		"NG_FILTER_ACTION_FLAG":        1,
+		"NG_FILTER_ACTION_MASK":        2,
+		"NG_FILTER_ACTION_SHADOW":      3,
+		"NG_FILTER_ACTION_BLOCK":       4,
+	}
+)
+
+func (x NGFilterAction) Enum() *NGFilterAction {
+	p := new(NGFilterAction)
+	*p = x
+	return p
+}
+
+func (x NGFilterAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NGFilterAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_funken_v1_ng_filter_proto_enumTypes[0].Descriptor()
+}
+
+func (NGFilterAction) Type() protoreflect.EnumType {
+	return &file_funken_v1_ng_filter_proto_enumTypes[0]
+}
+
+func (x NGFilterAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NGFilterAction.Descriptor instead.
+func (NGFilterAction) EnumDescriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{0}
+}
+
+type FilterStatsGrouping int32
+
+const (
+	// unspecified counts per filter.
+	FilterStatsGrouping_FILTER_STATS_GROUPING_UNSPECIFIED FilterStatsGrouping = 0
+	FilterStatsGrouping_FILTER_STATS_GROUPING_FILTER      FilterStatsGrouping = 1
+	FilterStatsGrouping_FILTER_STATS_GROUPING_GROUP       FilterStatsGrouping = 2
+)
+
+// Enum value maps for FilterStatsGrouping.
+var (
+	FilterStatsGrouping_name = map[int32]string{
+		0: "FILTER_STATS_GROUPING_UNSPECIFIED",
+		1: "FILTER_STATS_GROUPING_FILTER",
+		2: "FILTER_STATS_GROUPING_GROUP",
+	}
+	FilterStatsGrouping_value = map[string]int32{
+		"FILTER_STATS_GROUPING_UNSPECIFIED": 0,
+		"FILTER_STATS_GROUPING_FILTER":      1,
+		"FILTER_STATS_GROUPING_GROUP":       2,
+	}
+)
+
+func (x FilterStatsGrouping) Enum() *FilterStatsGrouping {
+	p := new(FilterStatsGrouping)
+	*p = x
+	return p
+}
+
+func (x FilterStatsGrouping) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterStatsGrouping) Descriptor() protoreflect.EnumDescriptor {
+	return file_funken_v1_ng_filter_proto_enumTypes[1].Descriptor()
+}
+
+func (FilterStatsGrouping) Type() protoreflect.EnumType {
+	return &file_funken_v1_ng_filter_proto_enumTypes[1]
+}
+
+func (x FilterStatsGrouping) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterStatsGrouping.Descriptor instead.
+func (FilterStatsGrouping) EnumDescriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{1}
+}
+
+type FilterStatsBucket int32
+
+const (
+	// unspecified counts the whole window at once.
+	FilterStatsBucket_FILTER_STATS_BUCKET_UNSPECIFIED FilterStatsBucket = 0
+	FilterStatsBucket_FILTER_STATS_BUCKET_HOUR        FilterStatsBucket = 1
+	FilterStatsBucket_FILTER_STATS_BUCKET_DAY         FilterStatsBucket = 2
+)
+
+// Enum value maps for FilterStatsBucket.
+var (
+	FilterStatsBucket_name = map[int32]string{
+		0: "FILTER_STATS_BUCKET_UNSPECIFIED",
+		1: "FILTER_STATS_BUCKET_HOUR",
+		2: "FILTER_STATS_BUCKET_DAY",
+	}
+	FilterStatsBucket_value = map[string]int32{
+		"FILTER_STATS_BUCKET_UNSPECIFIED": 0,
+		"FILTER_STATS_BUCKET_HOUR":        1,
+		"FILTER_STATS_BUCKET_DAY":         2,
+	}
+)
+
+func (x FilterStatsBucket) Enum() *FilterStatsBucket {
+	p := new(FilterStatsBucket)
+	*p = x
+	return p
+}
+
+func (x FilterStatsBucket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterStatsBucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_funken_v1_ng_filter_proto_enumTypes[2].Descriptor()
+}
+
+func (FilterStatsBucket) Type() protoreflect.EnumType {
+	return &file_funken_v1_ng_filter_proto_enumTypes[2]
+}
+
+func (x FilterStatsBucket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterStatsBucket.Descriptor instead.
+func (FilterStatsBucket) EnumDescriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{2}
+}
+
+type FilterListFormat int32
+
+const (
+	FilterListFormat_FILTER_LIST_FORMAT_UNSPECIFIED FilterListFormat = 0
+	// csv lists have a header row naming the title, pattern, flags and
+	// action columns, in any order. Only pattern is required.
+	FilterListFormat_FILTER_LIST_FORMAT_CSV FilterListFormat = 1
+	// json lists are an array of objects with the same fields.
+	FilterListFormat_FILTER_LIST_FORMAT_JSON FilterListFormat = 2
+)
+
+// Enum value maps for FilterListFormat.
+var (
+	FilterListFormat_name = map[int32]string{
+		0: "FILTER_LIST_FORMAT_UNSPECIFIED",
+		1: "FILTER_LIST_FORMAT_CSV",
+		2: "FILTER_LIST_FORMAT_JSON",
+	}
+	FilterListFormat_value = map[string]int32{
+		"FILTER_LIST_FORMAT_UNSPECIFIED": 0,
+		"FILTER_LIST_FORMAT_CSV":         1,
+		"FILTER_LIST_FORMAT_JSON":        2,
+	}
+)
+
+func (x FilterListFormat) Enum() *FilterListFormat {
+	p := new(FilterListFormat)
+	*p = x
+	return p
+}
+
+func (x FilterListFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterListFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_funken_v1_ng_filter_proto_enumTypes[3].Descriptor()
+}
+
+func (FilterListFormat) Type() protoreflect.EnumType {
+	return &file_funken_v1_ng_filter_proto_enumTypes[3]
+}
+
+func (x FilterListFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterListFormat.Descriptor instead.
+func (FilterListFormat) EnumDescriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{3}
+}
+
+type ImportMode int32
+
+const (
+	// unspecified merges.
+	ImportMode_IMPORT_MODE_UNSPECIFIED ImportMode = 0
+	// merge adds the filters whose pattern is new to the group.
+	ImportMode_IMPORT_MODE_MERGE ImportMode = 1
+	// replace makes the group hold exactly the imported filters.
+	ImportMode_IMPORT_MODE_REPLACE ImportMode = 2
+)
+
+// Enum value maps for ImportMode.
+var (
+	ImportMode_name = map[int32]string{
+		0: "IMPORT_MODE_UNSPECIFIED",
+		1: "IMPORT_MODE_MERGE",
+		2: "IMPORT_MODE_REPLACE",
+	}
+	ImportMode_value = map[string]int32{
+		"IMPORT_MODE_UNSPECIFIED": 0,
+		"IMPORT_MODE_MERGE":       1,
+		"IMPORT_MODE_REPLACE":     2,
+	}
+)
+
+func (x ImportMode) Enum() *ImportMode {
+	p := new(ImportMode)
+	*p = x
+	return p
+}
+
+func (x ImportMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_funken_v1_ng_filter_proto_enumTypes[4].Descriptor()
+}
+
+func (ImportMode) Type() protoreflect.EnumType {
+	return &file_funken_v1_ng_filter_proto_enumTypes[4]
+}
+
+func (x ImportMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportMode.Descriptor instead.
+func (ImportMode) EnumDescriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{4}
+}
+
+type GroupNGFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	GroupId       string                 `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Pattern       string                 `protobuf:"bytes,6,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Flags         string                 `protobuf:"bytes,7,opt,name=flags,proto3" json:"flags,omitempty"`
+	Action        NGFilterAction         `protobuf:"varint,8,opt,name=action,proto3,enum=funken.v1.NGFilterAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupNGFilter) Reset() {
+	*x = GroupNGFilter{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupNGFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupNGFilter) ProtoMessage() {}
+
+func (x *GroupNGFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupNGFilter.ProtoReflect.Descriptor instead.
+func (*GroupNGFilter) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GroupNGFilter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GroupNGFilter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GroupNGFilter) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GroupNGFilter) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupNGFilter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GroupNGFilter) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *GroupNGFilter) GetFlags() string {
+	if x != nil {
+		return x.Flags
+	}
+	return ""
+}
+
+func (x *GroupNGFilter) GetAction() NGFilterAction {
+	if x != nil {
+		return x.Action
+	}
+	return NGFilterAction_NG_FILTER_ACTION_UNSPECIFIED
+}
+
+type NGFilterInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Pattern       string                 `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Flags         string                 `protobuf:"bytes,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	Action        NGFilterAction         `protobuf:"varint,5,opt,name=action,proto3,enum=funken.v1.NGFilterAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NGFilterInput) Reset() {
+	*x = NGFilterInput{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NGFilterInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NGFilterInput) ProtoMessage() {}
+
+func (x *NGFilterInput) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NGFilterInput.ProtoReflect.Descriptor instead.
+func (*NGFilterInput) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NGFilterInput) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *NGFilterInput) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NGFilterInput) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *NGFilterInput) GetFlags() string {
+	if x != nil {
+		return x.Flags
+	}
+	return ""
+}
+
+func (x *NGFilterInput) GetAction() NGFilterAction {
+	if x != nil {
+		return x.Action
+	}
+	return NGFilterAction_NG_FILTER_ACTION_UNSPECIFIED
+}
+
+type CreateFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *NGFilterInput         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFilterRequest) Reset() {
+	*x = CreateFilterRequest{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFilterRequest) ProtoMessage() {}
+
+func (x *CreateFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFilterRequest.ProtoReflect.Descriptor instead.
+func (*CreateFilterRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateFilterRequest) GetFilter() *NGFilterInput {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type CreateFiltersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filters       []*NGFilterInput       `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFiltersRequest) Reset() {
+	*x = CreateFiltersRequest{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFiltersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFiltersRequest) ProtoMessage() {}
+
+func (x *CreateFiltersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFiltersRequest.ProtoReflect.Descriptor instead.
+func (*CreateFiltersRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateFiltersRequest) GetFilters() []*NGFilterInput {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type CreateFiltersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filters       []*GroupNGFilter       `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFiltersResponse) Reset() {
+	*x = CreateFiltersResponse{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFiltersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFiltersResponse) ProtoMessage() {}
+
+func (x *CreateFiltersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFiltersResponse.ProtoReflect.Descriptor instead.
+func (*CreateFiltersResponse) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateFiltersResponse) GetFilters() []*GroupNGFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type GetFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFilterRequest) Reset() {
+	*x = GetFilterRequest{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilterRequest) ProtoMessage() {}
+
+func (x *GetFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilterRequest.ProtoReflect.Descriptor instead.
+func (*GetFilterRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetFilterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListFiltersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// an empty group_id lists the global filters.
+	GroupId       string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFiltersRequest) Reset() {
+	*x = ListFiltersRequest{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFiltersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFiltersRequest) ProtoMessage() {}
+
+func (x *ListFiltersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFiltersRequest.ProtoReflect.Descriptor instead.
+func (*ListFiltersRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListFiltersRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ListFiltersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filters       []*GroupNGFilter       `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFiltersResponse) Reset() {
+	*x = ListFiltersResponse{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFiltersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFiltersResponse) ProtoMessage() {}
+
+func (x *ListFiltersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFiltersResponse.ProtoReflect.Descriptor instead.
+func (*ListFiltersResponse) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListFiltersResponse) GetFilters() []*GroupNGFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type UpdateFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Pattern       *string                `protobuf:"bytes,3,opt,name=pattern,proto3,oneof" json:"pattern,omitempty"`
+	Flags         *string                `protobuf:"bytes,4,opt,name=flags,proto3,oneof" json:"flags,omitempty"`
+	Action        *NGFilterAction        `protobuf:"varint,5,opt,name=action,proto3,enum=funken.v1.NGFilterAction,oneof" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFilterRequest) Reset() {
+	*x = UpdateFilterRequest{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFilterRequest) ProtoMessage() {}
+
+func (x *UpdateFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFilterRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFilterRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateFilterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFilterRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateFilterRequest) GetPattern() string {
+	if x != nil && x.Pattern != nil {
+		return *x.Pattern
+	}
+	return ""
+}
+
+func (x *UpdateFilterRequest) GetFlags() string {
+	if x != nil && x.Flags != nil {
+		return *x.Flags
+	}
+	return ""
+}
+
+func (x *UpdateFilterRequest) GetAction() NGFilterAction {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return NGFilterAction_NG_FILTER_ACTION_UNSPECIFIED
+}
+
+type DeleteFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFilterRequest) Reset() {
+	*x = DeleteFilterRequest{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFilterRequest) ProtoMessage() {}
+
+func (x *DeleteFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFilterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFilterRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteFilterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteFiltersByGroupIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupIds      []string               `protobuf:"bytes,1,rep,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFiltersByGroupIDsRequest) Reset() {
+	*x = DeleteFiltersByGroupIDsRequest{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFiltersByGroupIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFiltersByGroupIDsRequest) ProtoMessage() {}
+
+func (x *DeleteFiltersByGroupIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFiltersByGroupIDsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFiltersByGroupIDsRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteFiltersByGroupIDsRequest) GetGroupIds() []string {
+	if x != nil {
+		return x.GroupIds
+	}
+	return nil
+}
+
+type SetGlobalFilterOptOutRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	GroupId  string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	FilterId string                 `protobuf:"bytes,2,opt,name=filter_id,json=filterId,proto3" json:"filter_id,omitempty"`
+	// opt_out false opts the group back in.
+	OptOut        bool `protobuf:"varint,3,opt,name=opt_out,json=optOut,proto3" json:"opt_out,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGlobalFilterOptOutRequest) Reset() {
+	*x = SetGlobalFilterOptOutRequest{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGlobalFilterOptOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGlobalFilterOptOutRequest) ProtoMessage() {}
+
+func (x *SetGlobalFilterOptOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGlobalFilterOptOutRequest.ProtoReflect.Descriptor instead.
+func (*SetGlobalFilterOptOutRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetGlobalFilterOptOutRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetGlobalFilterOptOutRequest) GetFilterId() string {
+	if x != nil {
+		return x.FilterId
+	}
+	return ""
+}
+
+func (x *SetGlobalFilterOptOutRequest) GetOptOut() bool {
+	if x != nil {
+		return x.OptOut
+	}
+	return false
+}
+
+type GetEffectiveFiltersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEffectiveFiltersRequest) Reset() {
+	*x = GetEffectiveFiltersRequest{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEffectiveFiltersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectiveFiltersRequest) ProtoMessage() {}
+
+func (x *GetEffectiveFiltersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectiveFiltersRequest.ProtoReflect.Descriptor instead.
+func (*GetEffectiveFiltersRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetEffectiveFiltersRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type TestFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *NGFilterInput         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Samples       []string               `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestFilterRequest) Reset() {
+	*x = TestFilterRequest{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestFilterRequest) ProtoMessage() {}
+
+func (x *TestFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestFilterRequest.ProtoReflect.Descriptor instead.
+func (*TestFilterRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TestFilterRequest) GetFilter() *NGFilterInput {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *TestFilterRequest) GetSamples() []string {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+// MatchSpan is a byte range [start, end) of the sample text.
+type MatchSpan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchSpan) Reset() {
+	*x = MatchSpan{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchSpan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchSpan) ProtoMessage() {}
+
+func (x *MatchSpan) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchSpan.ProtoReflect.Descriptor instead.
+func (*MatchSpan) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MatchSpan) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *MatchSpan) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *MatchSpan) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SampleResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Spans         []*MatchSpan           `protobuf:"bytes,2,rep,name=spans,proto3" json:"spans,omitempty"`
+	Masked        string                 `protobuf:"bytes,3,opt,name=masked,proto3" json:"masked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SampleResult) Reset() {
+	*x = SampleResult{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SampleResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SampleResult) ProtoMessage() {}
+
+func (x *SampleResult) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SampleResult.ProtoReflect.Descriptor instead.
+func (*SampleResult) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SampleResult) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SampleResult) GetSpans() []*MatchSpan {
+	if x != nil {
+		return x.Spans
+	}
+	return nil
+}
+
+func (x *SampleResult) GetMasked() string {
+	if x != nil {
+		return x.Masked
+	}
+	return ""
+}
+
+type TestFilterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cost is the slowest evaluation measured against adversarial inputs.
+	// It is informational, filters are accepted on their program_size.
+	Cost          *durationpb.Duration `protobuf:"bytes,1,opt,name=cost,proto3" json:"cost,omitempty"`
+	Samples       []*SampleResult      `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+	ProgramSize   int64                `protobuf:"varint,3,opt,name=program_size,json=programSize,proto3" json:"program_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestFilterResponse) Reset() {
+	*x = TestFilterResponse{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestFilterResponse) ProtoMessage() {}
+
+func (x *TestFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestFilterResponse.ProtoReflect.Descriptor instead.
+func (*TestFilterResponse) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TestFilterResponse) GetCost() *durationpb.Duration {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+func (x *TestFilterResponse) GetSamples() []*SampleResult {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *TestFilterResponse) GetProgramSize() int64 {
+	if x != nil {
+		return x.ProgramSize
+	}
+	return 0
+}
+
+type GetFilterStatsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	GroupId  string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	FilterId string                 `protobuf:"bytes,2,opt,name=filter_id,json=filterId,proto3" json:"filter_id,omitempty"`
+	// the window defaults to the last 7 days, to being exclusive.
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	GroupBy       FilterStatsGrouping    `protobuf:"varint,5,opt,name=group_by,json=groupBy,proto3,enum=funken.v1.FilterStatsGrouping" json:"group_by,omitempty"`
+	Bucket        FilterStatsBucket      `protobuf:"varint,6,opt,name=bucket,proto3,enum=funken.v1.FilterStatsBucket" json:"bucket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFilterStatsRequest) Reset() {
+	*x = GetFilterStatsRequest{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFilterStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilterStatsRequest) ProtoMessage() {}
+
+func (x *GetFilterStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilterStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetFilterStatsRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetFilterStatsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetFilterStatsRequest) GetFilterId() string {
+	if x != nil {
+		return x.FilterId
+	}
+	return ""
+}
+
+func (x *GetFilterStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetFilterStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetFilterStatsRequest) GetGroupBy() FilterStatsGrouping {
+	if x != nil {
+		return x.GroupBy
+	}
+	return FilterStatsGrouping_FILTER_STATS_GROUPING_UNSPECIFIED
+}
+
+func (x *GetFilterStatsRequest) GetBucket() FilterStatsBucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return FilterStatsBucket_FILTER_STATS_BUCKET_UNSPECIFIED
+}
+
+type FilterStat struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter_id is empty when counting per group, group_id when counting
+	// per filter.
+	FilterId      string                 `protobuf:"bytes,1,opt,name=filter_id,json=filterId,proto3" json:"filter_id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Bucket        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Count         int64                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	LastHitAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_hit_at,json=lastHitAt,proto3" json:"last_hit_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterStat) Reset() {
+	*x = FilterStat{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterStat) ProtoMessage() {}
+
+func (x *FilterStat) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterStat.ProtoReflect.Descriptor instead.
+func (*FilterStat) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FilterStat) GetFilterId() string {
+	if x != nil {
+		return x.FilterId
+	}
+	return ""
+}
+
+func (x *FilterStat) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *FilterStat) GetBucket() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+func (x *FilterStat) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *FilterStat) GetLastHitAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHitAt
+	}
+	return nil
+}
+
+type GetFilterStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// stats are sorted by count, filters which did not fire are absent.
+	Stats         []*FilterStat `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFilterStatsResponse) Reset() {
+	*x = GetFilterStatsResponse{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFilterStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilterStatsResponse) ProtoMessage() {}
+
+func (x *GetFilterStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilterStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetFilterStatsResponse) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetFilterStatsResponse) GetStats() []*FilterStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type FilterHit struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FilterId  string                 `protobuf:"bytes,3,opt,name=filter_id,json=filterId,proto3" json:"filter_id,omitempty"`
+	GroupId   string                 `protobuf:"bytes,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	SenderId  string                 `protobuf:"bytes,5,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	// message_id is empty when the message was blocked.
+	MessageId     string         `protobuf:"bytes,6,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Action        NGFilterAction `protobuf:"varint,7,opt,name=action,proto3,enum=funken.v1.NGFilterAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterHit) Reset() {
+	*x = FilterHit{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterHit) ProtoMessage() {}
+
+func (x *FilterHit) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterHit.ProtoReflect.Descriptor instead.
+func (*FilterHit) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FilterHit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FilterHit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FilterHit) GetFilterId() string {
+	if x != nil {
+		return x.FilterId
+	}
+	return ""
+}
+
+func (x *FilterHit) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *FilterHit) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *FilterHit) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *FilterHit) GetAction() NGFilterAction {
+	if x != nil {
+		return x.Action
+	}
+	return NGFilterAction_NG_FILTER_ACTION_UNSPECIFIED
+}
+
+type ListFilterHitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	FilterId      string                 `protobuf:"bytes,2,opt,name=filter_id,json=filterId,proto3" json:"filter_id,omitempty"`
+	SenderId      string                 `protobuf:"bytes,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         int64                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFilterHitsRequest) Reset() {
+	*x = ListFilterHitsRequest{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFilterHitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilterHitsRequest) ProtoMessage() {}
+
+func (x *ListFilterHitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilterHitsRequest.ProtoReflect.Descriptor instead.
+func (*ListFilterHitsRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListFilterHitsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ListFilterHitsRequest) GetFilterId() string {
+	if x != nil {
+		return x.FilterId
+	}
+	return ""
+}
+
+func (x *ListFilterHitsRequest) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *ListFilterHitsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListFilterHitsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListFilterHitsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFilterHitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*FilterHit           `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFilterHitsResponse) Reset() {
+	*x = ListFilterHitsResponse{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFilterHitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilterHitsResponse) ProtoMessage() {}
+
+func (x *ListFilterHitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilterHitsResponse.ProtoReflect.Descriptor instead.
+func (*ListFilterHitsResponse) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListFilterHitsResponse) GetHits() []*FilterHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+type ImportFiltersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// an empty group_id imports global filters.
+	GroupId       string           `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Format        FilterListFormat `protobuf:"varint,2,opt,name=format,proto3,enum=funken.v1.FilterListFormat" json:"format,omitempty"`
+	Data          []byte           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Mode          ImportMode       `protobuf:"varint,4,opt,name=mode,proto3,enum=funken.v1.ImportMode" json:"mode,omitempty"`
+	DryRun        bool             `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportFiltersRequest) Reset() {
+	*x = ImportFiltersRequest{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFiltersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFiltersRequest) ProtoMessage() {}
+
+func (x *ImportFiltersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFiltersRequest.ProtoReflect.Descriptor instead.
+func (*ImportFiltersRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImportFiltersRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ImportFiltersRequest) GetFormat() FilterListFormat {
+	if x != nil {
+		return x.Format
+	}
+	return FilterListFormat_FILTER_LIST_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportFiltersRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportFiltersRequest) GetMode() ImportMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ImportMode_IMPORT_MODE_UNSPECIFIED
+}
+
+func (x *ImportFiltersRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type InvalidFilterEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// line is the CSV line, or the 1-based position in a JSON list.
+	Line          int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Pattern       string `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidFilterEntry) Reset() {
+	*x = InvalidFilterEntry{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidFilterEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidFilterEntry) ProtoMessage() {}
+
+func (x *InvalidFilterEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidFilterEntry.ProtoReflect.Descriptor instead.
+func (*InvalidFilterEntry) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *InvalidFilterEntry) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *InvalidFilterEntry) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *InvalidFilterEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportFiltersResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Created []*GroupNGFilter       `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Updated []*GroupNGFilter       `protobuf:"bytes,2,rep,name=updated,proto3" json:"updated,omitempty"`
+	Deleted []*GroupNGFilter       `protobuf:"bytes,3,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	// duplicates are the patterns skipped because the list repeats them or,
+	// when merging, the group already has them.
+	Duplicates []string              `protobuf:"bytes,4,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
+	Invalid    []*InvalidFilterEntry `protobuf:"bytes,5,rep,name=invalid,proto3" json:"invalid,omitempty"`
+	// applied is false for a dry run or when an entry is invalid.
+	Applied       bool `protobuf:"varint,6,opt,name=applied,proto3" json:"applied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportFiltersResponse) Reset() {
+	*x = ImportFiltersResponse{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFiltersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFiltersResponse) ProtoMessage() {}
+
+func (x *ImportFiltersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFiltersResponse.ProtoReflect.Descriptor instead.
+func (*ImportFiltersResponse) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportFiltersResponse) GetCreated() []*GroupNGFilter {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *ImportFiltersResponse) GetUpdated() []*GroupNGFilter {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *ImportFiltersResponse) GetDeleted() []*GroupNGFilter {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+func (x *ImportFiltersResponse) GetDuplicates() []string {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+func (x *ImportFiltersResponse) GetInvalid() []*InvalidFilterEntry {
+	if x != nil {
+		return x.Invalid
+	}
+	return nil
+}
+
+func (x *ImportFiltersResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type ExportFiltersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// an empty group_id exports global filters.
+	GroupId       string           `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Format        FilterListFormat `protobuf:"varint,2,opt,name=format,proto3,enum=funken.v1.FilterListFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportFiltersRequest) Reset() {
+	*x = ExportFiltersRequest{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportFiltersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportFiltersRequest) ProtoMessage() {}
+
+func (x *ExportFiltersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportFiltersRequest.ProtoReflect.Descriptor instead.
+func (*ExportFiltersRequest) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExportFiltersRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ExportFiltersRequest) GetFormat() FilterListFormat {
+	if x != nil {
+		return x.Format
+	}
+	return FilterListFormat_FILTER_LIST_FORMAT_UNSPECIFIED
+}
+
+type ExportFiltersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportFiltersResponse) Reset() {
+	*x = ExportFiltersResponse{}
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportFiltersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportFiltersResponse) ProtoMessage() {}
+
+func (x *ExportFiltersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_funken_v1_ng_filter_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportFiltersResponse.ProtoReflect.Descriptor instead.
+func (*ExportFiltersResponse) Descriptor() ([]byte, []int) {
+	return file_funken_v1_ng_filter_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExportFiltersResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportFiltersResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+var File_funken_v1_ng_filter_proto protoreflect.FileDescriptor
+
+const file_funken_v1_ng_filter_proto_rawDesc = "" +
+	"\n" +
+	"\x19funken/v1/ng_filter.proto\x12\tfunken.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa9\x02\n" +
+	"\rGroupNGFilter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x19\n" +
+	"\bgroup_id\x18\x04 \x01(\tR\agroupId\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x18\n" +
+	"\apattern\x18\x06 \x01(\tR\apattern\x12\x14\n" +
+	"\x05flags\x18\a \x01(\tR\x05flags\x121\n" +
+	"\x06action\x18\b \x01(\x0e2\x19.funken.v1.NGFilterActionR\x06action\"\xa3\x01\n" +
+	"\rNGFilterInput\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\apattern\x18\x03 \x01(\tR\apattern\x12\x14\n" +
+	"\x05flags\x18\x04 \x01(\tR\x05flags\x121\n" +
+	"\x06action\x18\x05 \x01(\x0e2\x19.funken.v1.NGFilterActionR\x06action\"G\n" +
+	"\x13CreateFilterRequest\x120\n" +
+	"\x06filter\x18\x01 \x01(\v2\x18.funken.v1.NGFilterInputR\x06filter\"J\n" +
+	"\x14CreateFiltersRequest\x122\n" +
+	"\afilters\x18\x01 \x03(\v2\x18.funken.v1.NGFilterInputR\afilters\"K\n" +
+	"\x15CreateFiltersResponse\x122\n" +
+	"\afilters\x18\x01 \x03(\v2\x18.funken.v1.GroupNGFilterR\afilters\"\"\n" +
+	"\x10GetFilterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x12ListFiltersRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"I\n" +
+	"\x13ListFiltersResponse\x122\n" +
+	"\afilters\x18\x01 \x03(\v2\x18.funken.v1.GroupNGFilterR\afilters\"\xdd\x01\n" +
+	"\x13UpdateFilterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1d\n" +
+	"\apattern\x18\x03 \x01(\tH\x01R\apattern\x88\x01\x01\x12\x19\n" +
+	"\x05flags\x18\x04 \x01(\tH\x02R\x05flags\x88\x01\x01\x126\n" +
+	"\x06action\x18\x05 \x01(\x0e2\x19.funken.v1.NGFilterActionH\x03R\x06action\x88\x01\x01B\b\n" +
+	"\x06_titleB\n" +
+	"\n" +
+	"\b_patternB\b\n" +
+	"\x06_flagsB\t\n" +
+	"\a_action\"%\n" +
+	"\x13DeleteFilterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
+	"\x1eDeleteFiltersByGroupIDsRequest\x12\x1b\n" +
+	"\tgroup_ids\x18\x01 \x03(\tR\bgroupIds\"o\n" +
+	"\x1cSetGlobalFilterOptOutRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
+	"\tfilter_id\x18\x02 \x01(\tR\bfilterId\x12\x17\n" +
+	"\aopt_out\x18\x03 \x01(\bR\x06optOut\"7\n" +
+	"\x1aGetEffectiveFiltersRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"_\n" +
+	"\x11TestFilterRequest\x120\n" +
+	"\x06filter\x18\x01 \x01(\v2\x18.funken.v1.NGFilterInputR\x06filter\x12\x18\n" +
+	"\asamples\x18\x02 \x03(\tR\asamples\"G\n" +
+	"\tMatchSpan\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x05R\x03end\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"f\n" +
+	"\fSampleResult\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12*\n" +
+	"\x05spans\x18\x02 \x03(\v2\x14.funken.v1.MatchSpanR\x05spans\x12\x16\n" +
+	"\x06masked\x18\x03 \x01(\tR\x06masked\"\x99\x01\n" +
+	"\x12TestFilterResponse\x12-\n" +
+	"\x04cost\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x04cost\x121\n" +
+	"\asamples\x18\x02 \x03(\v2\x17.funken.v1.SampleResultR\asamples\x12!\n" +
+	"\fprogram_size\x18\x03 \x01(\x03R\vprogramSize\"\x9c\x02\n" +
+	"\x15GetFilterStatsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
+	"\tfilter_id\x18\x02 \x01(\tR\bfilterId\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x129\n" +
+	"\bgroup_by\x18\x05 \x01(\x0e2\x1e.funken.v1.FilterStatsGroupingR\agroupBy\x124\n" +
+	"\x06bucket\x18\x06 \x01(\x0e2\x1c.funken.v1.FilterStatsBucketR\x06bucket\"\xca\x01\n" +
+	"\n" +
+	"FilterStat\x12\x1b\n" +
+	"\tfilter_id\x18\x01 \x01(\tR\bfilterId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x122\n" +
+	"\x06bucket\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06bucket\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x03R\x05count\x12:\n" +
+	"\vlast_hit_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tlastHitAt\"E\n" +
+	"\x16GetFilterStatsResponse\x12+\n" +
+	"\x05stats\x18\x01 \x03(\v2\x15.funken.v1.FilterStatR\x05stats\"\xfd\x01\n" +
+	"\tFilterHit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tfilter_id\x18\x03 \x01(\tR\bfilterId\x12\x19\n" +
+	"\bgroup_id\x18\x04 \x01(\tR\agroupId\x12\x1b\n" +
+	"\tsender_id\x18\x05 \x01(\tR\bsenderId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x06 \x01(\tR\tmessageId\x121\n" +
+	"\x06action\x18\a \x01(\x0e2\x19.funken.v1.NGFilterActionR\x06action\"\xde\x01\n" +
+	"\x15ListFilterHitsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
+	"\tfilter_id\x18\x02 \x01(\tR\bfilterId\x12\x1b\n" +
+	"\tsender_id\x18\x03 \x01(\tR\bsenderId\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x03R\x05limit\"B\n" +
+	"\x16ListFilterHitsResponse\x12(\n" +
+	"\x04hits\x18\x01 \x03(\v2\x14.funken.v1.FilterHitR\x04hits\"\xbe\x01\n" +
+	"\x14ImportFiltersRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x123\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1b.funken.v1.FilterListFormatR\x06format\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12)\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x15.funken.v1.ImportModeR\x04mode\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"X\n" +
+	"\x12InvalidFilterEntry\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xa6\x02\n" +
+	"\x15ImportFiltersResponse\x122\n" +
+	"\acreated\x18\x01 \x03(\v2\x18.funken.v1.GroupNGFilterR\acreated\x122\n" +
+	"\aupdated\x18\x02 \x03(\v2\x18.funken.v1.GroupNGFilterR\aupdated\x122\n" +
+	"\adeleted\x18\x03 \x03(\v2\x18.funken.v1.GroupNGFilterR\adeleted\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x04 \x03(\tR\n" +
+	"duplicates\x127\n" +
+	"\ainvalid\x18\x05 \x03(\v2\x1d.funken.v1.InvalidFilterEntryR\ainvalid\x12\x18\n" +
+	"\aapplied\x18\x06 \x01(\bR\aapplied\"f\n" +
+	"\x14ExportFiltersRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x123\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1b.funken.v1.FilterListFormatR\x06format\"N\n" +
+	"\x15ExportFiltersResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType*\xa1\x01\n" +
+	"\x0eNGFilterAction\x12 \n" +
+	"\x1cNG_FILTER_ACTION_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15NG_FILTER_ACTION_FLAG\x10\x01\x12\x19\n" +
+	"\x15NG_FILTER_ACTION_MASK\x10\x02\x12\x1b\n" +
+	"\x17NG_FILTER_ACTION_SHADOW\x10\x03\x12\x1a\n" +
+	"\x16NG_FILTER_ACTION_BLOCK\x10\x04*\x7f\n" +
+	"\x13FilterStatsGrouping\x12%\n" +
+	"!FILTER_STATS_GROUPING_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cFILTER_STATS_GROUPING_FILTER\x10\x01\x12\x1f\n" +
+	"\x1bFILTER_STATS_GROUPING_GROUP\x10\x02*s\n" +
+	"\x11FilterStatsBucket\x12#\n" +
+	"\x1fFILTER_STATS_BUCKET_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18FILTER_STATS_BUCKET_HOUR\x10\x01\x12\x1b\n" +
+	"\x17FILTER_STATS_BUCKET_DAY\x10\x02*o\n" +
+	"\x10FilterListFormat\x12\"\n" +
+	"\x1eFILTER_LIST_FORMAT_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16FILTER_LIST_FORMAT_CSV\x10\x01\x12\x1b\n" +
+	"\x17FILTER_LIST_FORMAT_JSON\x10\x02*Y\n" +
+	"\n" +
+	"ImportMode\x12\x1b\n" +
+	"\x17IMPORT_MODE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11IMPORT_MODE_MERGE\x10\x01\x12\x17\n" +
+	"\x13IMPORT_MODE_REPLACE\x10\x022\x8f\t\n" +
+	"\x14GroupNGFilterService\x12H\n" +
+	"\fCreateFilter\x12\x1e.funken.v1.CreateFilterRequest\x1a\x18.funken.v1.GroupNGFilter\x12R\n" +
+	"\rCreateFilters\x12\x1f.funken.v1.CreateFiltersRequest\x1a .funken.v1.CreateFiltersResponse\x12B\n" +
+	"\tGetFilter\x12\x1b.funken.v1.GetFilterRequest\x1a\x18.funken.v1.GroupNGFilter\x12L\n" +
+	"\vListFilters\x12\x1d.funken.v1.ListFiltersRequest\x1a\x1e.funken.v1.ListFiltersResponse\x12H\n" +
+	"\fUpdateFilter\x12\x1e.funken.v1.UpdateFilterRequest\x1a\x18.funken.v1.GroupNGFilter\x12F\n" +
+	"\fDeleteFilter\x12\x1e.funken.v1.DeleteFilterRequest\x1a\x16.google.protobuf.Empty\x12\\\n" +
+	"\x17DeleteFiltersByGroupIDs\x12).funken.v1.DeleteFiltersByGroupIDsRequest\x1a\x16.google.protobuf.Empty\x12X\n" +
+	"\x15SetGlobalFilterOptOut\x12'.funken.v1.SetGlobalFilterOptOutRequest\x1a\x16.google.protobuf.Empty\x12\\\n" +
+	"\x13GetEffectiveFilters\x12%.funken.v1.GetEffectiveFiltersRequest\x1a\x1e.funken.v1.ListFiltersResponse\x12U\n" +
+	"\x0eGetFilterStats\x12 .funken.v1.GetFilterStatsRequest\x1a!.funken.v1.GetFilterStatsResponse\x12U\n" +
+	"\x0eListFilterHits\x12 .funken.v1.ListFilterHitsRequest\x1a!.funken.v1.ListFilterHitsResponse\x12R\n" +
+	"\rImportFilters\x12\x1f.funken.v1.ImportFiltersRequest\x1a .funken.v1.ImportFiltersResponse\x12R\n" +
+	"\rExportFilters\x12\x1f.funken.v1.ExportFiltersRequest\x1a .funken.v1.ExportFiltersResponse\x12I\n" +
+	"\n" +
+	"TestFilter\x12\x1c.funken.v1.TestFilterRequest\x1a\x1d.funken.v1.TestFilterResponseB'Z%github.com/noxhalley/funken/pkg/pb;pbb\x06proto3"
+
+var (
+	file_funken_v1_ng_filter_proto_rawDescOnce sync.Once
+	file_funken_v1_ng_filter_proto_rawDescData []byte
+)
+
+func file_funken_v1_ng_filter_proto_rawDescGZIP() []byte {
+	file_funken_v1_ng_filter_proto_rawDescOnce.Do(func() {
+		file_funken_v1_ng_filter_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_funken_v1_ng_filter_proto_rawDesc), len(file_funken_v1_ng_filter_proto_rawDesc)))
+	})
+	return file_funken_v1_ng_filter_proto_rawDescData
+}
+
+var file_funken_v1_ng_filter_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_funken_v1_ng_filter_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_funken_v1_ng_filter_proto_goTypes = []any{
+	(NGFilterAction)(0),                    // 0: funken.v1.NGFilterAction
+	(FilterStatsGrouping)(0),               // 1: funken.v1.FilterStatsGrouping
+	(FilterStatsBucket)(0),                 // 2: funken.v1.FilterStatsBucket
+	(FilterListFormat)(0),                  // 3: funken.v1.FilterListFormat
+	(ImportMode)(0),                        // 4: funken.v1.ImportMode
+	(*GroupNGFilter)(nil),                  // 5: funken.v1.GroupNGFilter
+	(*NGFilterInput)(nil),                  // 6: funken.v1.NGFilterInput
+	(*CreateFilterRequest)(nil),            // 7: funken.v1.CreateFilterRequest
+	(*CreateFiltersRequest)(nil),           // 8: funken.v1.CreateFiltersRequest
+	(*CreateFiltersResponse)(nil),          // 9: funken.v1.CreateFiltersResponse
+	(*GetFilterRequest)(nil),               // 10: funken.v1.GetFilterRequest
+	(*ListFiltersRequest)(nil),             // 11: funken.v1.ListFiltersRequest
+	(*ListFiltersResponse)(nil),            // 12: funken.v1.ListFiltersResponse
+	(*UpdateFilterRequest)(nil),            // 13: funken.v1.UpdateFilterRequest
+	(*DeleteFilterRequest)(nil),            // 14: funken.v1.DeleteFilterRequest
+	(*DeleteFiltersByGroupIDsRequest)(nil), // 15: funken.v1.DeleteFiltersByGroupIDsRequest
+	(*SetGlobalFilterOptOutRequest)(nil),   // 16: funken.v1.SetGlobalFilterOptOutRequest
+	(*GetEffectiveFiltersRequest)(nil),     // 17: funken.v1.GetEffectiveFiltersRequest
+	(*TestFilterRequest)(nil),              // 18: funken.v1.TestFilterRequest
+	(*MatchSpan)(nil),                      // 19: funken.v1.MatchSpan
+	(*SampleResult)(nil),                   // 20: funken.v1.SampleResult
+	(*TestFilterResponse)(nil),             // 21: funken.v1.TestFilterResponse
+	(*GetFilterStatsRequest)(nil),          // 22: funken.v1.GetFilterStatsRequest
+	(*FilterStat)(nil),                     // 23: funken.v1.FilterStat
+	(*GetFilterStatsResponse)(nil),         // 24: funken.v1.GetFilterStatsResponse
+	(*FilterHit)(nil),                      // 25: funken.v1.FilterHit
+	(*ListFilterHitsRequest)(nil),          // 26: funken.v1.ListFilterHitsRequest
+	(*ListFilterHitsResponse)(nil),         // 27: funken.v1.ListFilterHitsResponse
+	(*ImportFiltersRequest)(nil),           // 28: funken.v1.ImportFiltersRequest
+	(*InvalidFilterEntry)(nil),             // 29: funken.v1.InvalidFilterEntry
+	(*ImportFiltersResponse)(nil),          // 30: funken.v1.ImportFiltersResponse
+	(*ExportFiltersRequest)(nil),           // 31: funken.v1.ExportFiltersRequest
+	(*ExportFiltersResponse)(nil),          // 32: funken.v1.ExportFiltersResponse
+	(*timestamppb.Timestamp)(nil),          // 33: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),            // 34: google.protobuf.Duration
+	(*emptypb.Empty)(nil),                  // 35: google.protobuf.Empty
+}
+var file_funken_v1_ng_filter_proto_depIdxs = []int32{
+	33, // 0: funken.v1.GroupNGFilter.created_at:type_name -> google.protobuf.Timestamp
+	33, // 1: funken.v1.GroupNGFilter.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: funken.v1.GroupNGFilter.action:type_name -> funken.v1.NGFilterAction
+	0,  // 3: funken.v1.NGFilterInput.action:type_name -> funken.v1.NGFilterAction
+	6,  // 4: funken.v1.CreateFilterRequest.filter:type_name -> funken.v1.NGFilterInput
+	6,  // 5: funken.v1.CreateFiltersRequest.filters:type_name -> funken.v1.NGFilterInput
+	5,  // 6: funken.v1.CreateFiltersResponse.filters:type_name -> funken.v1.GroupNGFilter
+	5,  // 7: funken.v1.ListFiltersResponse.filters:type_name -> funken.v1.GroupNGFilter
+	0,  // 8: funken.v1.UpdateFilterRequest.action:type_name -> funken.v1.NGFilterAction
+	6,  // 9: funken.v1.TestFilterRequest.filter:type_name -> funken.v1.NGFilterInput
+	19, // 10: funken.v1.SampleResult.spans:type_name -> funken.v1.MatchSpan
+	34, // 11: funken.v1.TestFilterResponse.cost:type_name -> google.protobuf.Duration
+	20, // 12: funken.v1.TestFilterResponse.samples:type_name -> funken.v1.SampleResult
+	33, // 13: funken.v1.GetFilterStatsRequest.from:type_name -> google.protobuf.Timestamp
+	33, // 14: funken.v1.GetFilterStatsRequest.to:type_name -> google.protobuf.Timestamp
+	1,  // 15: funken.v1.GetFilterStatsRequest.group_by:type_name -> funken.v1.FilterStatsGrouping
+	2,  // 16: funken.v1.GetFilterStatsRequest.bucket:type_name -> funken.v1.FilterStatsBucket
+	33, // 17: funken.v1.FilterStat.bucket:type_name -> google.protobuf.Timestamp
+	33, // 18: funken.v1.FilterStat.last_hit_at:type_name -> google.protobuf.Timestamp
+	23, // 19: funken.v1.GetFilterStatsResponse.stats:type_name -> funken.v1.FilterStat
+	33, // 20: funken.v1.FilterHit.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 21: funken.v1.FilterHit.action:type_name -> funken.v1.NGFilterAction
+	33, // 22: funken.v1.ListFilterHitsRequest.from:type_name -> google.protobuf.Timestamp
+	33, // 23: funken.v1.ListFilterHitsRequest.to:type_name -> google.protobuf.Timestamp
+	25, // 24: funken.v1.ListFilterHitsResponse.hits:type_name -> funken.v1.FilterHit
+	3,  // 25: funken.v1.ImportFiltersRequest.format:type_name -> funken.v1.FilterListFormat
+	4,  // 26: funken.v1.ImportFiltersRequest.mode:type_name -> funken.v1.ImportMode
+	5,  // 27: funken.v1.ImportFiltersResponse.created:type_name -> funken.v1.GroupNGFilter
+	5,  // 28: funken.v1.ImportFiltersResponse.updated:type_name -> funken.v1.GroupNGFilter
+	5,  // 29: funken.v1.ImportFiltersResponse.deleted:type_name -> funken.v1.GroupNGFilter
+	29, // 30: funken.v1.ImportFiltersResponse.invalid:type_name -> funken.v1.InvalidFilterEntry
+	3,  // 31: funken.v1.ExportFiltersRequest.format:type_name -> funken.v1.FilterListFormat
+	7,  // 32: funken.v1.GroupNGFilterService.CreateFilter:input_type -> funken.v1.CreateFilterRequest
+	8,  // 33: funken.v1.GroupNGFilterService.CreateFilters:input_type -> funken.v1.CreateFiltersRequest
+	10, // 34: funken.v1.GroupNGFilterService.GetFilter:input_type -> funken.v1.GetFilterRequest
+	11, // 35: funken.v1.GroupNGFilterService.ListFilters:input_type -> funken.v1.ListFiltersRequest
+	13, // 36: funken.v1.GroupNGFilterService.UpdateFilter:input_type -> funken.v1.UpdateFilterRequest
+	14, // 37: funken.v1.GroupNGFilterService.DeleteFilter:input_type -> funken.v1.DeleteFilterRequest
+	15, // 38: funken.v1.GroupNGFilterService.DeleteFiltersByGroupIDs:input_type -> funken.v1.DeleteFiltersByGroupIDsRequest
+	16, // 39: funken.v1.GroupNGFilterService.SetGlobalFilterOptOut:input_type -> funken.v1.SetGlobalFilterOptOutRequest
+	17, // 40: funken.v1.GroupNGFilterService.GetEffectiveFilters:input_type -> funken.v1.GetEffectiveFiltersRequest
+	22, // 41: funken.v1.GroupNGFilterService.GetFilterStats:input_type -> funken.v1.GetFilterStatsRequest
+	26, // 42: funken.v1.GroupNGFilterService.ListFilterHits:input_type -> funken.v1.ListFilterHitsRequest
+	28, // 43: funken.v1.GroupNGFilterService.ImportFilters:input_type -> funken.v1.ImportFiltersRequest
+	31, // 44: funken.v1.GroupNGFilterService.ExportFilters:input_type -> funken.v1.ExportFiltersRequest
+	18, // 45: funken.v1.GroupNGFilterService.TestFilter:input_type -> funken.v1.TestFilterRequest
+	5,  // 46: funken.v1.GroupNGFilterService.CreateFilter:output_type -> funken.v1.GroupNGFilter
+	9,  // 47: funken.v1.GroupNGFilterService.CreateFilters:output_type -> funken.v1.CreateFiltersResponse
+	5,  // 48: funken.v1.GroupNGFilterService.GetFilter:output_type -> funken.v1.GroupNGFilter
+	12, // 49: funken.v1.GroupNGFilterService.ListFilters:output_type -> funken.v1.ListFiltersResponse
+	5,  // 50: funken.v1.GroupNGFilterService.UpdateFilter:output_type -> funken.v1.GroupNGFilter
+	35, // 51: funken.v1.GroupNGFilterService.DeleteFilter:output_type -> google.protobuf.Empty
+	35, // 52: funken.v1.GroupNGFilterService.DeleteFiltersByGroupIDs:output_type -> google.protobuf.Empty
+	35, // 53: funken.v1.GroupNGFilterService.SetGlobalFilterOptOut:output_type -> google.protobuf.Empty
+	12, // 54: funken.v1.GroupNGFilterService.GetEffectiveFilters:output_type -> funken.v1.ListFiltersResponse
+	24, // 55: funken.v1.GroupNGFilterService.GetFilterStats:output_type -> funken.v1.GetFilterStatsResponse
+	27, // 56: funken.v1.GroupNGFilterService.ListFilterHits:output_type -> funken.v1.ListFilterHitsResponse
+	30, // 57: funken.v1.GroupNGFilterService.ImportFilters:output_type -> funken.v1.ImportFiltersResponse
+	32, // 58: funken.v1.GroupNGFilterService.ExportFilters:output_type -> funken.v1.ExportFiltersResponse
+	21, // 59: funken.v1.GroupNGFilterService.TestFilter:output_type -> funken.v1.TestFilterResponse
+	46, // [46:60] is the sub-list for method output_type
+	32, // [32:46] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
+}
+
+func init() { file_funken_v1_ng_filter_proto_init() }
+func file_funken_v1_ng_filter_proto_init() {
+	if File_funken_v1_ng_filter_proto != nil {
+		return
+	}
+	file_funken_v1_ng_filter_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_funken_v1_ng_filter_proto_rawDesc), len(file_funken_v1_ng_filter_proto_rawDesc)),
+			NumEnums:      5,
+			NumMessages:   28,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_funken_v1_ng_filter_proto_goTypes,
+		DependencyIndexes: file_funken_v1_ng_filter_proto_depIdxs,
+		EnumInfos:         file_funken_v1_ng_filter_proto_enumTypes,
+		MessageInfos:      file_funken_v1_ng_filter_proto_msgTypes,
+	}.Build()
+	File_funken_v1_ng_filter_proto = out.File
+	file_funken_v1_ng_filter_proto_goTypes = nil
+	file_funken_v1_ng_filter_proto_depIdxs = nil
+}
@@ -0,0 +1,626 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: funken/v1/ng_filter.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GroupNGFilterService_CreateFilter_FullMethodName            = "/funken.v1.GroupNGFilterService/CreateFilter"
+	GroupNGFilterService_CreateFilters_FullMethodName           = "/funken.v1.GroupNGFilterService/CreateFilters"
+	GroupNGFilterService_GetFilter_FullMethodName               = "/funken.v1.GroupNGFilterService/GetFilter"
+	GroupNGFilterService_ListFilters_FullMethodName             = "/funken.v1.GroupNGFilterService/ListFilters"
+	GroupNGFilterService_UpdateFilter_FullMethodName            = "/funken.v1.GroupNGFilterService/UpdateFilter"
+	GroupNGFilterService_DeleteFilter_FullMethodName            = "/funken.v1.GroupNGFilterService/DeleteFilter"
+	GroupNGFilterService_DeleteFiltersByGroupIDs_FullMethodName = "/funken.v1.GroupNGFilterService/DeleteFiltersByGroupIDs"
+	GroupNGFilterService_SetGlobalFilterOptOut_FullMethodName   = "/funken.v1.GroupNGFilterService/SetGlobalFilterOptOut"
+	GroupNGFilterService_GetEffectiveFilters_FullMethodName     = "/funken.v1.GroupNGFilterService/GetEffectiveFilters"
+	GroupNGFilterService_GetFilterStats_FullMethodName          = "/funken.v1.GroupNGFilterService/GetFilterStats"
+	GroupNGFilterService_ListFilterHits_FullMethodName          = "/funken.v1.GroupNGFilterService/ListFilterHits"
+	GroupNGFilterService_ImportFilters_FullMethodName           = "/funken.v1.GroupNGFilterService/ImportFilters"
+	GroupNGFilterService_ExportFilters_FullMethodName           = "/funken.v1.GroupNGFilterService/ExportFilters"
+	GroupNGFilterService_TestFilter_FullMethodName              = "/funken.v1.GroupNGFilterService/TestFilter"
+)
+
+// GroupNGFilterServiceClient is the client API for GroupNGFilterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GroupNGFilterServiceClient interface {
+	CreateFilter(ctx context.Context, in *CreateFilterRequest, opts ...grpc.CallOption) (*GroupNGFilter, error)
+	CreateFilters(ctx context.Context, in *CreateFiltersRequest, opts ...grpc.CallOption) (*CreateFiltersResponse, error)
+	GetFilter(ctx context.Context, in *GetFilterRequest, opts ...grpc.CallOption) (*GroupNGFilter, error)
+	ListFilters(ctx context.Context, in *ListFiltersRequest, opts ...grpc.CallOption) (*ListFiltersResponse, error)
+	UpdateFilter(ctx context.Context, in *UpdateFilterRequest, opts ...grpc.CallOption) (*GroupNGFilter, error)
+	DeleteFilter(ctx context.Context, in *DeleteFilterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteFiltersByGroupIDs(ctx context.Context, in *DeleteFiltersByGroupIDsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetGlobalFilterOptOut(ctx context.Context, in *SetGlobalFilterOptOutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetEffectiveFilters returns the filters evaluated for a group: the
+	// global ones it did not opt out of, then its own.
+	GetEffectiveFilters(ctx context.Context, in *GetEffectiveFiltersRequest, opts ...grpc.CallOption) (*ListFiltersResponse, error)
+	GetFilterStats(ctx context.Context, in *GetFilterStatsRequest, opts ...grpc.CallOption) (*GetFilterStatsResponse, error)
+	// ListFilterHits returns the most recent hits first.
+	ListFilterHits(ctx context.Context, in *ListFilterHitsRequest, opts ...grpc.CallOption) (*ListFilterHitsResponse, error)
+	ImportFilters(ctx context.Context, in *ImportFiltersRequest, opts ...grpc.CallOption) (*ImportFiltersResponse, error)
+	ExportFilters(ctx context.Context, in *ExportFiltersRequest, opts ...grpc.CallOption) (*ExportFiltersResponse, error)
+	// TestFilter validates a candidate filter and runs it against sample
+	// texts without storing it.
+	TestFilter(ctx context.Context, in *TestFilterRequest, opts ...grpc.CallOption) (*TestFilterResponse, error)
+}
+
+type groupNGFilterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGroupNGFilterServiceClient(cc grpc.ClientConnInterface) GroupNGFilterServiceClient {
+	return &groupNGFilterServiceClient{cc}
+}
+
+func (c *groupNGFilterServiceClient) CreateFilter(ctx context.Context, in *CreateFilterRequest, opts ...grpc.CallOption) (*GroupNGFilter, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupNGFilter)
+	err := c.cc.Invoke(ctx, GroupNGFilterService_CreateFilter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupNGFilterServiceClient) CreateFilters(ctx context.Context, in *CreateFiltersRequest, opts ...grpc.CallOption) (*CreateFiltersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFiltersResponse)
+	err := c.cc.Invoke(ctx, GroupNGFilterService_CreateFilters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupNGFilterServiceClient) GetFilter(ctx context.Context, in *GetFilterRequest, opts ...grpc.CallOption) (*GroupNGFilter, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupNGFilter)
+	err := c.cc.Invoke(ctx, GroupNGFilterService_GetFilter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupNGFilterServiceClient) ListFilters(ctx context.Context, in *ListFiltersRequest, opts ...grpc.CallOption) (*ListFiltersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFiltersResponse)
+	err := c.cc.Invoke(ctx, GroupNGFilterService_ListFilters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupNGFilterServiceClient) UpdateFilter(ctx context.Context, in *UpdateFilterRequest, opts ...grpc.CallOption) (*GroupNGFilter, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupNGFilter)
+	err := c.cc.Invoke(ctx, GroupNGFilterService_UpdateFilter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupNGFilterServiceClient) DeleteFilter(ctx context.Context, in *DeleteFilterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GroupNGFilterService_DeleteFilter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupNGFilterServiceClient) DeleteFiltersByGroupIDs(ctx context.Context, in *DeleteFiltersByGroupIDsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GroupNGFilterService_DeleteFiltersByGroupIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupNGFilterServiceClient) SetGlobalFilterOptOut(ctx context.Context, in *SetGlobalFilterOptOutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GroupNGFilterService_SetGlobalFilterOptOut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupNGFilterServiceClient) GetEffectiveFilters(ctx context.Context, in *GetEffectiveFiltersRequest, opts ...grpc.CallOption) (*ListFiltersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFiltersResponse)
+	err := c.cc.Invoke(ctx, GroupNGFilterService_GetEffectiveFilters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupNGFilterServiceClient) GetFilterStats(ctx context.Context, in *GetFilterStatsRequest, opts ...grpc.CallOption) (*GetFilterStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFilterStatsResponse)
+	err := c.cc.Invoke(ctx, GroupNGFilterService_GetFilterStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupNGFilterServiceClient) ListFilterHits(ctx context.Context, in *ListFilterHitsRequest, opts ...grpc.CallOption) (*ListFilterHitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFilterHitsResponse)
+	err := c.cc.Invoke(ctx, GroupNGFilterService_ListFilterHits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupNGFilterServiceClient) ImportFilters(ctx context.Context, in *ImportFiltersRequest, opts ...grpc.CallOption) (*ImportFiltersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportFiltersResponse)
+	err := c.cc.Invoke(ctx, GroupNGFilterService_ImportFilters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupNGFilterServiceClient) ExportFilters(ctx context.Context, in *ExportFiltersRequest, opts ...grpc.CallOption) (*ExportFiltersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportFiltersResponse)
+	err := c.cc.Invoke(ctx, GroupNGFilterService_ExportFilters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupNGFilterServiceClient) TestFilter(ctx context.Context, in *TestFilterRequest, opts ...grpc.CallOption) (*TestFilterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestFilterResponse)
+	err := c.cc.Invoke(ctx, GroupNGFilterService_TestFilter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupNGFilterServiceServer is the server API for GroupNGFilterService service.
+// All implementations must embed UnimplementedGroupNGFilterServiceServer
+// for forward compatibility.
+type GroupNGFilterServiceServer interface {
+	CreateFilter(context.Context, *CreateFilterRequest) (*GroupNGFilter, error)
+	CreateFilters(context.Context, *CreateFiltersRequest) (*CreateFiltersResponse, error)
+	GetFilter(context.Context, *GetFilterRequest) (*GroupNGFilter, error)
+	ListFilters(context.Context, *ListFiltersRequest) (*ListFiltersResponse, error)
+	UpdateFilter(context.Context, *UpdateFilterRequest) (*GroupNGFilter, error)
+	DeleteFilter(context.Context, *DeleteFilterRequest) (*emptypb.Empty, error)
+	DeleteFiltersByGroupIDs(context.Context, *DeleteFiltersByGroupIDsRequest) (*emptypb.Empty, error)
+	SetGlobalFilterOptOut(context.Context, *SetGlobalFilterOptOutRequest) (*emptypb.Empty, error)
+	// GetEffectiveFilters returns the filters evaluated for a group: the
+	// global ones it did not opt out of, then its own.
+	GetEffectiveFilters(context.Context, *GetEffectiveFiltersRequest) (*ListFiltersResponse, error)
+	GetFilterStats(context.Context, *GetFilterStatsRequest) (*GetFilterStatsResponse, error)
+	// ListFilterHits returns the most recent hits first.
+	ListFilterHits(context.Context, *ListFilterHitsRequest) (*ListFilterHitsResponse, error)
+	ImportFilters(context.Context, *ImportFiltersRequest) (*ImportFiltersResponse, error)
+	ExportFilters(context.Context, *ExportFiltersRequest) (*ExportFiltersResponse, error)
+	// TestFilter validates a candidate filter and runs it against sample
+	// texts without storing it.
+	TestFilter(context.Context, *TestFilterRequest) (*TestFilterResponse, error)
+	mustEmbedUnimplementedGroupNGFilterServiceServer()
+}
+
+// UnimplementedGroupNGFilterServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGroupNGFilterServiceServer struct{}
+
+func (UnimplementedGroupNGFilterServiceServer) CreateFilter(context.Context, *CreateFilterRequest) (*GroupNGFilter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFilter not implemented")
+}
+func (UnimplementedGroupNGFilterServiceServer) CreateFilters(context.Context, *CreateFiltersRequest) (*CreateFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFilters not implemented")
+}
+func (UnimplementedGroupNGFilterServiceServer) GetFilter(context.Context, *GetFilterRequest) (*GroupNGFilter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFilter not implemented")
+}
+func (UnimplementedGroupNGFilterServiceServer) ListFilters(context.Context, *ListFiltersRequest) (*ListFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFilters not implemented")
+}
+func (UnimplementedGroupNGFilterServiceServer) UpdateFilter(context.Context, *UpdateFilterRequest) (*GroupNGFilter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFilter not implemented")
+}
+func (UnimplementedGroupNGFilterServiceServer) DeleteFilter(context.Context, *DeleteFilterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFilter not implemented")
+}
+func (UnimplementedGroupNGFilterServiceServer) DeleteFiltersByGroupIDs(context.Context, *DeleteFiltersByGroupIDsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFiltersByGroupIDs not implemented")
+}
+func (UnimplementedGroupNGFilterServiceServer) SetGlobalFilterOptOut(context.Context, *SetGlobalFilterOptOutRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGlobalFilterOptOut not implemented")
+}
+func (UnimplementedGroupNGFilterServiceServer) GetEffectiveFilters(context.Context, *GetEffectiveFiltersRequest) (*ListFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectiveFilters not implemented")
+}
+func (UnimplementedGroupNGFilterServiceServer) GetFilterStats(context.Context, *GetFilterStatsRequest) (*GetFilterStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFilterStats not implemented")
+}
+func (UnimplementedGroupNGFilterServiceServer) ListFilterHits(context.Context, *ListFilterHitsRequest) (*ListFilterHitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFilterHits not implemented")
+}
+func (UnimplementedGroupNGFilterServiceServer) ImportFilters(context.Context, *ImportFiltersRequest) (*ImportFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportFilters not implemented")
+}
+func (UnimplementedGroupNGFilterServiceServer) ExportFilters(context.Context, *ExportFiltersRequest) (*ExportFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportFilters not implemented")
+}
+func (UnimplementedGroupNGFilterServiceServer) TestFilter(context.Context, *TestFilterRequest) (*TestFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestFilter not implemented")
+}
+func (UnimplementedGroupNGFilterServiceServer) mustEmbedUnimplementedGroupNGFilterServiceServer() {}
+func (UnimplementedGroupNGFilterServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeGroupNGFilterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroupNGFilterServiceServer will
+// result in compilation errors.
+type UnsafeGroupNGFilterServiceServer interface {
+	mustEmbedUnimplementedGroupNGFilterServiceServer()
+}
+
+func RegisterGroupNGFilterServiceServer(s grpc.ServiceRegistrar, srv GroupNGFilterServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGroupNGFilterServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GroupNGFilterService_ServiceDesc, srv)
+}
+
+func _GroupNGFilterService_CreateFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupNGFilterServiceServer).CreateFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupNGFilterService_CreateFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupNGFilterServiceServer).CreateFilter(ctx, req.(*CreateFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupNGFilterService_CreateFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupNGFilterServiceServer).CreateFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupNGFilterService_CreateFilters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupNGFilterServiceServer).CreateFilters(ctx, req.(*CreateFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupNGFilterService_GetFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupNGFilterServiceServer).GetFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupNGFilterService_GetFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupNGFilterServiceServer).GetFilter(ctx, req.(*GetFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupNGFilterService_ListFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupNGFilterServiceServer).ListFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupNGFilterService_ListFilters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupNGFilterServiceServer).ListFilters(ctx, req.(*ListFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupNGFilterService_UpdateFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupNGFilterServiceServer).UpdateFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupNGFilterService_UpdateFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupNGFilterServiceServer).UpdateFilter(ctx, req.(*UpdateFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupNGFilterService_DeleteFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupNGFilterServiceServer).DeleteFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupNGFilterService_DeleteFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupNGFilterServiceServer).DeleteFilter(ctx, req.(*DeleteFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupNGFilterService_DeleteFiltersByGroupIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFiltersByGroupIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupNGFilterServiceServer).DeleteFiltersByGroupIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupNGFilterService_DeleteFiltersByGroupIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupNGFilterServiceServer).DeleteFiltersByGroupIDs(ctx, req.(*DeleteFiltersByGroupIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupNGFilterService_SetGlobalFilterOptOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGlobalFilterOptOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupNGFilterServiceServer).SetGlobalFilterOptOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupNGFilterService_SetGlobalFilterOptOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupNGFilterServiceServer).SetGlobalFilterOptOut(ctx, req.(*SetGlobalFilterOptOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupNGFilterService_GetEffectiveFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEffectiveFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupNGFilterServiceServer).GetEffectiveFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupNGFilterService_GetEffectiveFilters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupNGFilterServiceServer).GetEffectiveFilters(ctx, req.(*GetEffectiveFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupNGFilterService_GetFilterStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFilterStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupNGFilterServiceServer).GetFilterStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupNGFilterService_GetFilterStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupNGFilterServiceServer).GetFilterStats(ctx, req.(*GetFilterStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupNGFilterService_ListFilterHits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFilterHitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupNGFilterServiceServer).ListFilterHits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupNGFilterService_ListFilterHits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupNGFilterServiceServer).ListFilterHits(ctx, req.(*ListFilterHitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupNGFilterService_ImportFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupNGFilterServiceServer).ImportFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupNGFilterService_ImportFilters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupNGFilterServiceServer).ImportFilters(ctx, req.(*ImportFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupNGFilterService_ExportFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupNGFilterServiceServer).ExportFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupNGFilterService_ExportFilters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupNGFilterServiceServer).ExportFilters(ctx, req.(*ExportFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupNGFilterService_TestFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupNGFilterServiceServer).TestFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupNGFilterService_TestFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupNGFilterServiceServer).TestFilter(ctx, req.(*TestFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GroupNGFilterService_ServiceDesc is the grpc.ServiceDesc for GroupNGFilterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GroupNGFilterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "funken.v1.GroupNGFilterService",
+	HandlerType: (*GroupNGFilterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFilter",
+			Handler:    _GroupNGFilterService_CreateFilter_Handler,
+		},
+		{
+			MethodName: "CreateFilters",
+			Handler:    _GroupNGFilterService_CreateFilters_Handler,
+		},
+		{
+			MethodName: "GetFilter",
+			Handler:    _GroupNGFilterService_GetFilter_Handler,
+		},
+		{
+			MethodName: "ListFilters",
+			Handler:    _GroupNGFilterService_ListFilters_Handler,
+		},
+		{
+			MethodName: "UpdateFilter",
+			Handler:    _GroupNGFilterService_UpdateFilter_Handler,
+		},
+		{
+			MethodName: "DeleteFilter",
+			Handler:    _GroupNGFilterService_DeleteFilter_Handler,
+		},
+		{
+			MethodName: "DeleteFiltersByGroupIDs",
+			Handler:    _GroupNGFilterService_DeleteFiltersByGroupIDs_Handler,
+		},
+		{
+			MethodName: "SetGlobalFilterOptOut",
+			Handler:    _GroupNGFilterService_SetGlobalFilterOptOut_Handler,
+		},
+		{
+			MethodName: "GetEffectiveFilters",
+			Handler:    _GroupNGFilterService_GetEffectiveFilters_Handler,
+		},
+		{
+			MethodName: "GetFilterStats",
+			Handler:    _GroupNGFilterService_GetFilterStats_Handler,
+		},
+		{
+			MethodName: "ListFilterHits",
+			Handler:    _GroupNGFilterService_ListFilterHits_Handler,
+		},
+		{
+			MethodName: "ImportFilters",
+			Handler:    _GroupNGFilterService_ImportFilters_Handler,
+		},
+		{
+			MethodName: "ExportFilters",
+			Handler:    _GroupNGFilterService_ExportFilters_Handler,
+		},
+		{
+			MethodName: "TestFilter",
+			Handler:    _GroupNGFilterService_TestFilter_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "funken/v1/ng_filter.proto",
+}